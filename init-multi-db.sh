@@ -4,4 +4,5 @@ set -e
 psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" <<-EOSQL
     CREATE DATABASE auth;
     CREATE DATABASE account;
+    CREATE DATABASE payment;
 EOSQL
\ No newline at end of file
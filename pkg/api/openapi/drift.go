@@ -0,0 +1,134 @@
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+)
+
+// routeParam - chi param with optional regexp, e.g. {id:[0-9]+}
+var routeParam = regexp.MustCompile(`\{([^}:]+)(:[^}]*)?\}`)
+
+// CheckRoutes - every route of routers has to be operation of spec and every operation route of one of routers,
+// so handlers can't drift from documented API, meant to be called from tests of services that build their routers.
+// Only methods and paths are compared, path param names as part of path; query params are checked by
+// CheckQueryParams, headers and bodies aren't checked. Routes with paths in skip (and SpecPath, DocsPath) are not checked
+func CheckRoutes(spec *openapi3.T, routers []chi.Routes, skip ...string) error {
+	skip = append(skip, SpecPath, DocsPath)
+
+	routes := make(map[string]struct{})
+	for _, router := range routers {
+		err := chi.Walk(router, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+			route = normalize(route)
+			if !slices.Contains(skip, route) {
+				routes[method+" "+route] = struct{}{}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("openapi: walk routes: %w", err)
+		}
+	}
+
+	var errs []error
+	for path, item := range spec.Paths.Map() {
+		for method := range item.Operations() {
+			key := method + " " + normalize(path)
+			if _, ok := routes[key]; !ok {
+				errs = append(errs, fmt.Errorf("%s is in spec but not routed", key))
+			}
+			delete(routes, key)
+		}
+	}
+
+	for key := range routes {
+		errs = append(errs, fmt.Errorf("%s is routed but not in spec", key))
+	}
+
+	slices.SortFunc(errs, func(a, b error) int {
+		return strings.Compare(a.Error(), b.Error())
+	})
+
+	return errors.Join(errs...)
+}
+
+// normalize - route without param regexps and trailing slash
+func normalize(route string) string {
+	route = routeParam.ReplaceAllString(route, "{$1}")
+	if len(route) > 1 {
+		route = strings.TrimSuffix(route, "/")
+	}
+	return route
+}
+
+// CheckQueryParams - query params of every operation of spec have to be the fields (by form tag) of Params struct
+// generated for it and the other way round, so handlers that take generated params can't miss params of spec,
+// e.g. when generated code isn't regenerated after change of spec. params maps operationId to Params struct
+func CheckQueryParams(spec *openapi3.T, params map[string]interface{}) error {
+	var errs []error
+	checked := make(map[string]struct{})
+	for _, item := range spec.Paths.Map() {
+		for _, op := range item.Operations() {
+			inSpec := make(map[string]struct{})
+			for _, param := range append(item.Parameters, op.Parameters...) {
+				if param.Value != nil && param.Value.In == openapi3.ParameterInQuery {
+					inSpec[param.Value.Name] = struct{}{}
+				}
+			}
+
+			v, ok := params[op.OperationID]
+			if !ok {
+				if len(inSpec) > 0 {
+					errs = append(errs, fmt.Errorf("%s has query params but no params struct", op.OperationID))
+				}
+				continue
+			}
+			checked[op.OperationID] = struct{}{}
+
+			for _, name := range formNames(reflect.TypeOf(v)) {
+				if _, ok := inSpec[name]; !ok {
+					errs = append(errs, fmt.Errorf("%s: query param %s is bound but not in spec", op.OperationID, name))
+				}
+				delete(inSpec, name)
+			}
+			for name := range inSpec {
+				errs = append(errs, fmt.Errorf("%s: query param %s is in spec but not bound", op.OperationID, name))
+			}
+		}
+	}
+
+	for operationID := range params {
+		if _, ok := checked[operationID]; ok {
+			continue
+		}
+		errs = append(errs, fmt.Errorf("%s is not operation of spec", operationID))
+	}
+
+	slices.SortFunc(errs, func(a, b error) int {
+		return strings.Compare(a.Error(), b.Error())
+	})
+
+	return errors.Join(errs...)
+}
+
+// formNames - names in form tags of struct fields, the tags generated server binds query params by
+func formNames(t reflect.Type) []string {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		if name, _, _ := strings.Cut(t.Field(i).Tag.Get("form"), ","); name != "" && name != "-" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
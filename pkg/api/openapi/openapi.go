@@ -0,0 +1,77 @@
+// Package openapi - serving of OpenAPI spec of service with Swagger UI and check that routers match the spec
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+)
+
+// Paths of spec and Swagger UI, they are not part of spec and skipped by CheckRoutes
+const (
+	SpecPath = "/openapi.json"
+	DocsPath = "/docs"
+)
+
+// Mount - serves spec as JSON on SpecPath and Swagger UI for it on DocsPath
+func Mount(r chi.Router, spec *openapi3.T) {
+	r.Get(SpecPath, SpecHandler(spec))
+	r.Get(DocsPath, SwaggerUIHandler(spec.Info.Title, SpecPath))
+}
+
+// SpecHandler - spec is marshalled once, invalid spec is programming error
+func SpecHandler(spec *openapi3.T) http.HandlerFunc {
+	body, err := json.Marshal(spec)
+	if err != nil {
+		panic(fmt.Sprintf("openapi: marshal spec: %v", err))
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}
+}
+
+// swaggerUIVersion - swagger-ui-dist loaded from CDN
+const swaggerUIVersion = "5.17.14"
+
+var swaggerUI = template.Must(template.New("swagger-ui").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>{{.Title}}</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@{{.Version}}/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@{{.Version}}/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = () => {
+      window.ui = SwaggerUIBundle({url: {{.SpecURL}}, dom_id: '#swagger-ui'});
+    };
+  </script>
+</body>
+</html>
+`))
+
+// SwaggerUIHandler - Swagger UI page for spec served on specURL
+func SwaggerUIHandler(title, specURL string) http.HandlerFunc {
+	data := struct {
+		Title, Version, SpecURL string
+	}{Title: title, Version: swaggerUIVersion, SpecURL: specURL}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_ = swaggerUI.Execute(w, data)
+	}
+}
+
+// ParamErrorHandler - error handler of generated server wrappers, failed binding of param is bad request
+func ParamErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	problem.Write(w, r, problem.BadRequest.New(err.Error()))
+}
@@ -0,0 +1,40 @@
+package problem
+
+import "net/http"
+
+// Generic problems
+var (
+	BadRequest          = Kind{Code: "bad_request", Status: http.StatusBadRequest, Title: "Bad request"}
+	Unauthorized        = Kind{Code: "unauthorized", Status: http.StatusUnauthorized, Title: "Unauthorized"}
+	Forbidden           = Kind{Code: "forbidden", Status: http.StatusForbidden, Title: "Forbidden"}
+	NotFound            = Kind{Code: "not_found", Status: http.StatusNotFound, Title: "Not found"}
+	Conflict            = Kind{Code: "conflict", Status: http.StatusConflict, Title: "Conflict"}
+	AlreadyExists       = Kind{Code: "already_exists", Status: http.StatusConflict, Title: "Already exists"}
+	IdempotencyMismatch = Kind{Code: "idempotency_key_reused", Status: http.StatusUnprocessableEntity, Title: "Idempotency key was used for different request"}
+	ValidationFailed    = Kind{Code: "validation_failed", Status: http.StatusBadRequest, Title: "Request validation failed"}
+	PayloadTooLarge     = Kind{Code: "payload_too_large", Status: http.StatusRequestEntityTooLarge, Title: "Payload too large"}
+	UnprocessableEntity = Kind{Code: "unprocessable_entity", Status: http.StatusUnprocessableEntity, Title: "Request can't be processed"}
+	Internal            = Kind{Code: "internal_error", Status: http.StatusInternalServerError, Title: "Internal server error"}
+	Unavailable         = Kind{Code: "service_unavailable", Status: http.StatusServiceUnavailable, Title: "Service unavailable"}
+)
+
+// Users and authentication
+var (
+	InvalidCredentials = Kind{Code: "invalid_credentials", Status: http.StatusUnauthorized, Title: "Invalid credentials"}
+	UserNotFound       = Kind{Code: "user_not_found", Status: http.StatusNotFound, Title: "User not found"}
+	UserAlreadyExists  = Kind{Code: "user_already_exists", Status: http.StatusConflict, Title: "User already exists"}
+)
+
+// Accounts and money movement
+var (
+	AccountNotFound          = Kind{Code: "account_not_found", Status: http.StatusNotFound, Title: "Account not found"}
+	AccountNotActive         = Kind{Code: "account_not_active", Status: http.StatusConflict, Title: "Account is not active"}
+	InsufficientFunds        = Kind{Code: "insufficient_funds", Status: http.StatusUnprocessableEntity, Title: "Insufficient funds"}
+	LimitExceeded            = Kind{Code: "limit_exceeded", Status: http.StatusUnprocessableEntity, Title: "Limit exceeded"}
+	TransactionLimitExceeded = Kind{Code: "transaction_limit_exceeded", Status: http.StatusUnprocessableEntity, Title: "Per transaction limit exceeded"}
+	DailyLimitExceeded       = Kind{Code: "daily_limit_exceeded", Status: http.StatusUnprocessableEntity, Title: "Daily limit exceeded"}
+	MonthlyLimitExceeded     = Kind{Code: "monthly_limit_exceeded", Status: http.StatusUnprocessableEntity, Title: "Monthly limit exceeded"}
+	PaymentDenied            = Kind{Code: "payment_denied", Status: http.StatusUnprocessableEntity, Title: "Payment denied"}
+	BeneficiaryCoolingOff    = Kind{Code: "beneficiary_cooling_off", Status: http.StatusUnprocessableEntity, Title: "Beneficiary is in cooling-off period"}
+	BeneficiaryRequired      = Kind{Code: "beneficiary_required", Status: http.StatusUnprocessableEntity, Title: "Saved beneficiary is required"}
+)
@@ -0,0 +1,116 @@
+// Package problem - API errors as RFC 7807 problem details
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// ContentType - media type of problem details
+const ContentType = "application/problem+json"
+
+// typePrefix - type of problem is relative URI made of its code, e.g. /problems/insufficient_funds
+const typePrefix = "/problems/"
+
+// Kind - entry of error catalogue, Code is stable and meant for clients to react on
+type Kind struct {
+	Code   string
+	Status int
+	Title  string
+}
+
+// New - problem of kind, detail is human-readable explanation of this occurrence
+func (k Kind) New(detail string) *Problem {
+	return &Problem{
+		Type:   typePrefix + k.Code,
+		Title:  k.Title,
+		Status: k.Status,
+		Detail: detail,
+		Code:   k.Code,
+	}
+}
+
+// Problem - RFC 7807 problem details extended with code, request id and details (e.g. invalid fields)
+type Problem struct {
+	Type      string      `json:"type"`
+	Title     string      `json:"title"`
+	Status    int         `json:"status"`
+	Detail    string      `json:"detail,omitempty"`
+	Instance  string      `json:"instance,omitempty"`
+	Code      string      `json:"code"`
+	RequestID string      `json:"request_id,omitempty"`
+	Details   interface{} `json:"details,omitempty"`
+}
+
+// WithDetails - attaches machine-readable details
+func (p *Problem) WithDetails(details interface{}) *Problem {
+	p.Details = details
+	return p
+}
+
+func (p *Problem) Error() string {
+	if p.Detail == "" {
+		return p.Code
+	}
+	return p.Code + ": " + p.Detail
+}
+
+type mapping struct {
+	err  error
+	kind Kind
+}
+
+var (
+	mu       sync.RWMutex
+	mappings []mapping
+)
+
+// Map - errors matching err (errors.Is) are written as kind by WriteError, first registered mapping wins
+func Map(err error, kind Kind) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	mappings = append(mappings, mapping{err: err, kind: kind})
+}
+
+// FromError - problem for err: err itself if it's a Problem, mapped kind with message of err,
+// Internal without any detail for unknown errors, as they may reveal internals
+func FromError(err error) *Problem {
+	var p *Problem
+	if errors.As(err, &p) {
+		return p
+	}
+
+	mu.RLock()
+	defer mu.RUnlock()
+
+	for _, m := range mappings {
+		if errors.Is(err, m.err) {
+			return m.kind.New(m.err.Error())
+		}
+	}
+
+	return Internal.New("")
+}
+
+// Write - writes p as application/problem+json, request id and instance are taken from r
+func Write(w http.ResponseWriter, r *http.Request, p *Problem) {
+	p.RequestID = middleware.GetReqID(r.Context())
+	if p.Instance == "" {
+		p.Instance = r.URL.Path
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+// WriteError - writes problem for err, see FromError
+func WriteError(w http.ResponseWriter, r *http.Request, err error) {
+	Write(w, r, FromError(err))
+}
@@ -0,0 +1,185 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/R1ckNash/Bank/pkg/retry"
+)
+
+const timeoutDefault = 5 * time.Second
+
+// idempotencyKeyHeader - account service returns result of first transfer for repeated key
+const idempotencyKeyHeader = "Idempotency-Key"
+
+var (
+	ErrAccountNotFound   = errors.New("account client: account not found")
+	ErrAccountNotActive  = errors.New("account client: account is not active")
+	ErrInsufficientFunds = errors.New("account client: insufficient funds")
+	ErrLimitExceeded     = errors.New("account client: limit exceeded")
+	ErrForbidden         = errors.New("account client: forbidden")
+	ErrRejected          = errors.New("account client: request rejected")
+	ErrUnauthorized      = errors.New("account client: unauthorized")
+	ErrUnavailable       = errors.New("account client: service unavailable")
+	ErrUnexpectedStatus  = errors.New("account client: unexpected status")
+)
+
+type clientOptions struct {
+	timeout     time.Duration
+	retryPolicy retry.Policy
+}
+
+type ClientOption func(options *clientOptions)
+
+// WithTimeout - timeout of a whole call including retries
+func WithTimeout(d time.Duration) ClientOption {
+	return func(opts *clientOptions) {
+		opts.timeout = d
+	}
+}
+
+// WithRetryPolicy - retries of unavailable service; requests that aren't idempotent are never retried
+func WithRetryPolicy(policy retry.Policy) ClientOption {
+	return func(opts *clientOptions) {
+		opts.retryPolicy = policy
+	}
+}
+
+// Client - typed client of account service internal API
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	timeout    time.Duration
+	retry      retry.Policy
+}
+
+// New - returns Client, httpClient must attach service credentials (see pkg/httpclient)
+func New(baseURL string, httpClient *http.Client, opts ...ClientOption) *Client {
+	options := &clientOptions{
+		timeout:     timeoutDefault,
+		retryPolicy: retry.DefaultPolicy(),
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: httpClient,
+		timeout:    options.timeout,
+		retry:      options.retryPolicy,
+	}
+}
+
+// GetAccount - account by number, requires ScopeAccountsRead
+func (c *Client) GetAccount(ctx context.Context, number string) (Account, error) {
+	var account Account
+	if err := c.do(ctx, http.MethodGet, "/internal/account/"+number, nil, "", &account); err != nil {
+		return Account{}, err
+	}
+
+	return account, nil
+}
+
+// Transfer - moves money on behalf of owner of source account, requires ScopeTransfersWrite.
+// Transfer is retried on unavailability only when IdempotencyKey is set
+func (c *Client) Transfer(ctx context.Context, req TransferRequest) (Transaction, error) {
+	var resp transferResponse
+	if err := c.do(ctx, http.MethodPost, "/internal/transfers", req, req.IdempotencyKey, &resp); err != nil {
+		return Transaction{}, err
+	}
+
+	return resp.Transaction, nil
+}
+
+// do - executes request with retries, decodes response into dest if it's not nil
+func (c *Client) do(ctx context.Context, method, path string, body interface{}, idempotencyKey string, dest interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	policy := c.retry
+	policy.Retryable = func(err error) bool {
+		return (method == http.MethodGet || idempotencyKey != "") && errors.Is(err, ErrUnavailable)
+	}
+
+	return retry.Do(ctx, policy, func(ctx context.Context) error {
+		return c.doOnce(ctx, method, path, body, idempotencyKey, dest)
+	})
+}
+
+func (c *Client) doOnce(ctx context.Context, method, path string, body interface{}, idempotencyKey string, dest interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return retry.Permanent(fmt.Errorf("account client: encode request: %w", err))
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return retry.Permanent(fmt.Errorf("account client: build request: %w", err))
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if idempotencyKey != "" {
+		req.Header.Set(idempotencyKeyHeader, idempotencyKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("%w: %d", ErrUnavailable, resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return retry.Permanent(statusError(resp))
+	}
+
+	if dest == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(dest); err != nil {
+		return retry.Permanent(fmt.Errorf("account client: decode response: %w", err))
+	}
+
+	return nil
+}
+
+// statusError - maps rejected request to error, message of account service is kept for logs
+func statusError(resp *http.Response) error {
+	var body errorResponse
+	_ = json.NewDecoder(resp.Body).Decode(&body)
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrAccountNotFound
+	case resp.StatusCode == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case resp.StatusCode == http.StatusForbidden:
+		return ErrForbidden
+	case resp.StatusCode == http.StatusConflict:
+		return fmt.Errorf("%w: %s", ErrAccountNotActive, body.message())
+	case body.Code == "insufficient_funds":
+		return ErrInsufficientFunds
+	case strings.HasSuffix(body.Code, "limit_exceeded"):
+		return fmt.Errorf("%w: %s", ErrLimitExceeded, body.Code)
+	case resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnprocessableEntity:
+		return fmt.Errorf("%w: %s", ErrRejected, body.message())
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedStatus, resp.StatusCode)
+	}
+}
@@ -0,0 +1,63 @@
+package account
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Account - public data of account
+type Account struct {
+	Number           string    `json:"number"`
+	OwnerID          uuid.UUID `json:"owner_id"`
+	Name             string    `json:"name"`
+	Currency         string    `json:"currency"`
+	Product          string    `json:"product"`
+	Balance          string    `json:"balance"`
+	AvailableBalance string    `json:"available_balance"`
+	Status           string    `json:"status"`
+}
+
+// TransferRequest - Amount is decimal string in currency of From account,
+// IdempotencyKey makes retries of the same transfer safe
+type TransferRequest struct {
+	From           string    `json:"from"`
+	To             string    `json:"to"`
+	Amount         string    `json:"amount"`
+	Description    string    `json:"description,omitempty"`
+	InitiatedBy    uuid.UUID `json:"initiated_by"`
+	IdempotencyKey string    `json:"-"`
+}
+
+// Transaction - executed transfer
+type Transaction struct {
+	ID             uuid.UUID `json:"id"`
+	Type           string    `json:"type"`
+	From           string    `json:"from"`
+	To             string    `json:"to"`
+	DebitAmount    string    `json:"debit_amount"`
+	DebitCurrency  string    `json:"debit_currency"`
+	CreditAmount   string    `json:"credit_amount"`
+	CreditCurrency string    `json:"credit_currency"`
+	Description    string    `json:"description"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type transferResponse struct {
+	Transaction Transaction `json:"transaction"`
+}
+
+// errorResponse - problem details of rejected request, Code is stable identifier e.g. "daily_limit_exceeded"
+type errorResponse struct {
+	Code   string `json:"code"`
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+}
+
+// message - detail if account service gave one, title otherwise
+func (e errorResponse) message() string {
+	if e.Detail != "" {
+		return e.Detail
+	}
+	return e.Title
+}
@@ -0,0 +1,8 @@
+package account
+
+// Scopes of account service internal API, granted to service clients by auth service
+const (
+	ScopeAccountsRead   = "accounts:read"
+	ScopeTransfersWrite = "transfers:write"
+	ScopeHoldsWrite     = "holds:write"
+)
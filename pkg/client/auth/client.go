@@ -0,0 +1,226 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/R1ckNash/Bank/pkg/resilience"
+	"github.com/R1ckNash/Bank/pkg/retry"
+	"github.com/google/uuid"
+)
+
+const (
+	timeoutDefault          = 3 * time.Second
+	breakerThresholdDefault = 5
+	breakerCooldownDefault  = 10 * time.Second
+	bulkheadSizeDefault     = 50
+	bulkheadWaitDefault     = 100 * time.Millisecond
+)
+
+// Scopes the client needs, token source of httpClient should request them
+const (
+	ScopeUsersRead        = "users:read"
+	ScopeTokensIntrospect = "tokens:introspect"
+)
+
+var (
+	ErrUserNotFound     = errors.New("auth client: user not found")
+	ErrUnauthorized     = errors.New("auth client: unauthorized")
+	ErrUnavailable      = errors.New("auth client: service unavailable")
+	ErrUnexpectedStatus = errors.New("auth client: unexpected status")
+)
+
+type clientOptions struct {
+	timeout          time.Duration
+	breakerThreshold int
+	breakerCooldown  time.Duration
+	bulkheadSize     int
+	bulkheadWait     time.Duration
+	retryPolicy      retry.Policy
+}
+
+type ClientOption func(options *clientOptions)
+
+// WithTimeout - timeout of a whole call including retries
+func WithTimeout(d time.Duration) ClientOption {
+	return func(opts *clientOptions) {
+		opts.timeout = d
+	}
+}
+
+// WithCircuitBreaker - open circuit after threshold consecutive failures for cooldown
+func WithCircuitBreaker(threshold int, cooldown time.Duration) ClientOption {
+	return func(opts *clientOptions) {
+		opts.breakerThreshold = threshold
+		opts.breakerCooldown = cooldown
+	}
+}
+
+// WithBulkhead - at most size concurrent requests, request waits for free slot up to wait
+func WithBulkhead(size int, wait time.Duration) ClientOption {
+	return func(opts *clientOptions) {
+		opts.bulkheadSize = size
+		opts.bulkheadWait = wait
+	}
+}
+
+// WithRetryPolicy - retries of unavailable service, other errors are never retried
+func WithRetryPolicy(policy retry.Policy) ClientOption {
+	return func(opts *clientOptions) {
+		opts.retryPolicy = policy
+	}
+}
+
+// Client - typed client of auth service internal API
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	timeout    time.Duration
+	breaker    *resilience.Breaker
+	bulkhead   *resilience.Bulkhead
+	retry      retry.Policy
+}
+
+// New - returns Client, httpClient must attach service credentials (see pkg/httpclient)
+func New(baseURL string, httpClient *http.Client, opts ...ClientOption) *Client {
+	options := &clientOptions{
+		timeout:          timeoutDefault,
+		breakerThreshold: breakerThresholdDefault,
+		breakerCooldown:  breakerCooldownDefault,
+		bulkheadSize:     bulkheadSizeDefault,
+		bulkheadWait:     bulkheadWaitDefault,
+		retryPolicy:      retry.DefaultPolicy(),
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	// only unavailability of auth service counts as failure, e.g. unknown user doesn't
+	breaker := resilience.NewBreaker(resilience.BreakerSettings{
+		Name:             "auth",
+		FailureThreshold: options.breakerThreshold,
+		OpenTimeout:      options.breakerCooldown,
+		IsFailure:        isFailure,
+	})
+
+	c := &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: httpClient,
+		timeout:    options.timeout,
+		breaker:    breaker,
+		bulkhead:   resilience.NewBulkhead("auth", options.bulkheadSize, options.bulkheadWait),
+		retry:      options.retryPolicy,
+	}
+	c.retry.Retryable = isFailure
+
+	return c
+}
+
+// Verify - nil if user exists
+func (c *Client) Verify(ctx context.Context, userID uuid.UUID) error {
+	return c.do(ctx, http.MethodGet, "/user/verify/"+userID.String(), nil, nil)
+}
+
+// GetUser - user by id
+func (c *Client) GetUser(ctx context.Context, userID uuid.UUID) (User, error) {
+	var user User
+	if err := c.do(ctx, http.MethodGet, "/user/"+userID.String(), nil, &user); err != nil {
+		return User{}, err
+	}
+
+	return user, nil
+}
+
+// IntrospectToken - checks token, inactive tokens are not an error
+func (c *Client) IntrospectToken(ctx context.Context, token string) (TokenInfo, error) {
+	form := url.Values{}
+	form.Set("token", token)
+
+	var resp introspectResponse
+	if err := c.do(ctx, http.MethodPost, "/oauth/introspect", form, &resp); err != nil {
+		return TokenInfo{}, err
+	}
+
+	return resp.toTokenInfo(), nil
+}
+
+// do - executes request with retries, circuit breaker and bulkhead, decodes response into dest if it's not nil
+func (c *Client) do(ctx context.Context, method, path string, form url.Values, dest interface{}) error {
+	callCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return retry.Do(callCtx, c.retry, func(attemptCtx context.Context) error {
+		err := c.bulkhead.Execute(attemptCtx, func(attemptCtx context.Context) error {
+			return c.breaker.Execute(attemptCtx, func(attemptCtx context.Context) error {
+				err := c.doOnce(attemptCtx, method, path, form, dest)
+				// caller gave up, it says nothing about auth service
+				if ctxErr := ctx.Err(); ctxErr != nil {
+					return ctxErr
+				}
+				return err
+			})
+		})
+		// auth service is known to be down or overloaded, retry can't help
+		if errors.Is(err, resilience.ErrCircuitOpen) || errors.Is(err, resilience.ErrBulkheadFull) {
+			return retry.Permanent(fmt.Errorf("%w: %w", ErrUnavailable, err))
+		}
+
+		return err
+	})
+}
+
+func (c *Client) doOnce(ctx context.Context, method, path string, form url.Values, dest interface{}) error {
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return retry.Permanent(fmt.Errorf("auth client: build request: %w", err))
+	}
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		// error isn't wrapped: expired timeout of call or attempt is slow auth service, not context error of caller
+		return fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return retry.Permanent(ErrUserNotFound)
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return retry.Permanent(ErrUnauthorized)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return fmt.Errorf("%w: %d", ErrUnavailable, resp.StatusCode)
+	case resp.StatusCode != http.StatusOK:
+		return retry.Permanent(fmt.Errorf("%w: %d", ErrUnexpectedStatus, resp.StatusCode))
+	}
+
+	if dest == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(dest); err != nil {
+		return retry.Permanent(fmt.Errorf("auth client: decode response: %w", err))
+	}
+
+	return nil
+}
+
+// isFailure - only unavailability of auth service counts for circuit breaker and is retried,
+// cancellation and deadline of caller's context don't
+func isFailure(err error) bool {
+	return errors.Is(err, ErrUnavailable) && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
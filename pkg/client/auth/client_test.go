@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/R1ckNash/Bank/pkg/resilience"
+	"github.com/R1ckNash/Bank/pkg/retry"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(url string) *Client {
+	return New(url, http.DefaultClient,
+		WithCircuitBreaker(1, time.Minute),
+		WithRetryPolicy(retry.Policy{MaxAttempts: 2, InitialDelay: time.Millisecond, Multiplier: 1}),
+	)
+}
+
+func TestClient_CallerContextIsNotFailure(t *testing.T) {
+	var slow atomic.Bool
+	slow.Store(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if slow.Load() {
+			<-r.Context().Done()
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := client.Verify(ctx, uuid.New())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.NotErrorIs(t, err, ErrUnavailable)
+
+	// breaker with threshold 1 stays closed
+	slow.Store(false)
+	require.NoError(t, client.Verify(context.Background(), uuid.New()))
+}
+
+func TestClient_UnavailableOpensBreaker(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+
+	err := client.Verify(context.Background(), uuid.New())
+	require.ErrorIs(t, err, ErrUnavailable)
+	require.ErrorIs(t, err, resilience.ErrCircuitOpen)
+	require.EqualValues(t, 1, calls.Load())
+}
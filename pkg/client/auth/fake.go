@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Fake - in-memory replacement of Client for tests
+type Fake struct {
+	mu     sync.RWMutex
+	users  map[uuid.UUID]User
+	tokens map[string]TokenInfo
+	err    error
+}
+
+func NewFake() *Fake {
+	return &Fake{
+		users:  make(map[uuid.UUID]User),
+		tokens: make(map[string]TokenInfo),
+	}
+}
+
+// AddUser - user becomes visible for Verify and GetUser
+func (f *Fake) AddUser(user User) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.users[user.ID] = user
+}
+
+// AddToken - info is returned by IntrospectToken for token, unknown tokens are inactive
+func (f *Fake) AddToken(token string, info TokenInfo) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.tokens[token] = info
+}
+
+// SetError - every call returns err until it's reset with nil
+func (f *Fake) SetError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.err = err
+}
+
+func (f *Fake) Verify(ctx context.Context, userID uuid.UUID) error {
+	_, err := f.GetUser(ctx, userID)
+	return err
+}
+
+func (f *Fake) GetUser(_ context.Context, userID uuid.UUID) (User, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if f.err != nil {
+		return User{}, f.err
+	}
+
+	user, ok := f.users[userID]
+	if !ok {
+		return User{}, ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+func (f *Fake) IntrospectToken(_ context.Context, token string) (TokenInfo, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if f.err != nil {
+		return TokenInfo{}, f.err
+	}
+
+	return f.tokens[token], nil
+}
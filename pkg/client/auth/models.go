@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// User - user of auth service without credentials
+type User struct {
+	ID        uuid.UUID `json:"id"`
+	Username  string    `json:"username"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TokenInfo - result of token introspection
+type TokenInfo struct {
+	Active    bool
+	Type      string // "user" or "service"
+	Subject   string // user id or service client id
+	Scope     string
+	ExpiresAt time.Time
+	IssuedAt  time.Time
+}
+
+type introspectResponse struct {
+	Active bool   `json:"active"`
+	Type   string `json:"typ"`
+	Sub    string `json:"sub"`
+	Scope  string `json:"scope"`
+	Exp    int64  `json:"exp"`
+	Iat    int64  `json:"iat"`
+}
+
+func (r introspectResponse) toTokenInfo() TokenInfo {
+	if !r.Active {
+		return TokenInfo{Active: false}
+	}
+
+	return TokenInfo{
+		Active:    true,
+		Type:      r.Type,
+		Subject:   r.Sub,
+		Scope:     r.Scope,
+		ExpiresAt: time.Unix(r.Exp, 0),
+		IssuedAt:  time.Unix(r.Iat, 0),
+	}
+}
@@ -0,0 +1,77 @@
+package currency
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrUnknownCurrency     = errors.New("currency: unknown ISO 4217 code")
+	ErrUnsupportedCurrency = errors.New("currency: not supported")
+)
+
+// RoundingMode - how amounts are rounded to minor units
+type RoundingMode string
+
+const (
+	// RoundHalfEven - banker's rounding, default for all currencies
+	RoundHalfEven RoundingMode = "half_even"
+	RoundHalfUp   RoundingMode = "half_up"
+	RoundDown     RoundingMode = "down"
+)
+
+// IsValid - known rounding mode
+func (m RoundingMode) IsValid() bool {
+	switch m {
+	case RoundHalfEven, RoundHalfUp, RoundDown:
+		return true
+	}
+	return false
+}
+
+// Currency - ISO 4217 currency with rounding rule
+type Currency struct {
+	Code       string
+	Numeric    string
+	MinorUnits int32
+	Name       string
+	Symbol     string
+	Rounding   RoundingMode
+}
+
+// Lookup - currency from ISO 4217 registry, code is case-insensitive
+func Lookup(code string) (Currency, error) {
+	c, ok := iso4217[strings.ToUpper(code)]
+	if !ok {
+		return Currency{}, fmt.Errorf("%w: %q", ErrUnknownCurrency, code)
+	}
+
+	c.Code = strings.ToUpper(code)
+	c.Rounding = RoundHalfEven
+	return c, nil
+}
+
+// Round - amount rounded to minor units of currency using its rounding mode
+func (c Currency) Round(amount decimal.Decimal) decimal.Decimal {
+	switch c.Rounding {
+	case RoundHalfUp:
+		return amount.Round(c.MinorUnits)
+	case RoundDown:
+		return amount.RoundDown(c.MinorUnits)
+	default:
+		return amount.RoundBank(c.MinorUnits)
+	}
+}
+
+// Format - amount with exactly MinorUnits decimals, e.g. "1234.50"
+func (c Currency) Format(amount decimal.Decimal) string {
+	return c.Round(amount).StringFixed(c.MinorUnits)
+}
+
+// Display - human-readable amount, e.g. "1234.50 EUR"
+func (c Currency) Display(amount decimal.Decimal) string {
+	return c.Format(amount) + " " + c.Code
+}
@@ -0,0 +1,160 @@
+package currency
+
+// iso4217 - active ISO 4217 currencies (funds and precious metals are omitted)
+var iso4217 = map[string]Currency{
+	"AED": {Numeric: "784", MinorUnits: 2, Name: "UAE Dirham"},
+	"AFN": {Numeric: "971", MinorUnits: 2, Name: "Afghani", Symbol: "؋"},
+	"ALL": {Numeric: "008", MinorUnits: 2, Name: "Lek"},
+	"AMD": {Numeric: "051", MinorUnits: 2, Name: "Armenian Dram", Symbol: "֏"},
+	"ANG": {Numeric: "532", MinorUnits: 2, Name: "Netherlands Antillean Guilder"},
+	"AOA": {Numeric: "973", MinorUnits: 2, Name: "Kwanza"},
+	"ARS": {Numeric: "032", MinorUnits: 2, Name: "Argentine Peso", Symbol: "$"},
+	"AUD": {Numeric: "036", MinorUnits: 2, Name: "Australian Dollar", Symbol: "A$"},
+	"AWG": {Numeric: "533", MinorUnits: 2, Name: "Aruban Florin"},
+	"AZN": {Numeric: "944", MinorUnits: 2, Name: "Azerbaijan Manat", Symbol: "₼"},
+	"BAM": {Numeric: "977", MinorUnits: 2, Name: "Convertible Mark"},
+	"BBD": {Numeric: "052", MinorUnits: 2, Name: "Barbados Dollar"},
+	"BDT": {Numeric: "050", MinorUnits: 2, Name: "Taka", Symbol: "৳"},
+	"BGN": {Numeric: "975", MinorUnits: 2, Name: "Bulgarian Lev"},
+	"BHD": {Numeric: "048", MinorUnits: 3, Name: "Bahraini Dinar"},
+	"BIF": {Numeric: "108", MinorUnits: 0, Name: "Burundi Franc"},
+	"BMD": {Numeric: "060", MinorUnits: 2, Name: "Bermudian Dollar"},
+	"BND": {Numeric: "096", MinorUnits: 2, Name: "Brunei Dollar"},
+	"BOB": {Numeric: "068", MinorUnits: 2, Name: "Boliviano"},
+	"BRL": {Numeric: "986", MinorUnits: 2, Name: "Brazilian Real", Symbol: "R$"},
+	"BSD": {Numeric: "044", MinorUnits: 2, Name: "Bahamian Dollar"},
+	"BTN": {Numeric: "064", MinorUnits: 2, Name: "Ngultrum"},
+	"BWP": {Numeric: "072", MinorUnits: 2, Name: "Pula"},
+	"BYN": {Numeric: "933", MinorUnits: 2, Name: "Belarusian Ruble"},
+	"BZD": {Numeric: "084", MinorUnits: 2, Name: "Belize Dollar"},
+	"CAD": {Numeric: "124", MinorUnits: 2, Name: "Canadian Dollar", Symbol: "C$"},
+	"CDF": {Numeric: "976", MinorUnits: 2, Name: "Congolese Franc"},
+	"CHF": {Numeric: "756", MinorUnits: 2, Name: "Swiss Franc"},
+	"CLP": {Numeric: "152", MinorUnits: 0, Name: "Chilean Peso"},
+	"CNY": {Numeric: "156", MinorUnits: 2, Name: "Yuan Renminbi", Symbol: "¥"},
+	"COP": {Numeric: "170", MinorUnits: 2, Name: "Colombian Peso"},
+	"CRC": {Numeric: "188", MinorUnits: 2, Name: "Costa Rican Colon", Symbol: "₡"},
+	"CUP": {Numeric: "192", MinorUnits: 2, Name: "Cuban Peso"},
+	"CVE": {Numeric: "132", MinorUnits: 2, Name: "Cabo Verde Escudo"},
+	"CZK": {Numeric: "203", MinorUnits: 2, Name: "Czech Koruna", Symbol: "Kč"},
+	"DJF": {Numeric: "262", MinorUnits: 0, Name: "Djibouti Franc"},
+	"DKK": {Numeric: "208", MinorUnits: 2, Name: "Danish Krone", Symbol: "kr"},
+	"DOP": {Numeric: "214", MinorUnits: 2, Name: "Dominican Peso"},
+	"DZD": {Numeric: "012", MinorUnits: 2, Name: "Algerian Dinar"},
+	"EGP": {Numeric: "818", MinorUnits: 2, Name: "Egyptian Pound"},
+	"ERN": {Numeric: "232", MinorUnits: 2, Name: "Nakfa"},
+	"ETB": {Numeric: "230", MinorUnits: 2, Name: "Ethiopian Birr"},
+	"EUR": {Numeric: "978", MinorUnits: 2, Name: "Euro", Symbol: "€"},
+	"FJD": {Numeric: "242", MinorUnits: 2, Name: "Fiji Dollar"},
+	"FKP": {Numeric: "238", MinorUnits: 2, Name: "Falkland Islands Pound"},
+	"GBP": {Numeric: "826", MinorUnits: 2, Name: "Pound Sterling", Symbol: "£"},
+	"GEL": {Numeric: "981", MinorUnits: 2, Name: "Lari", Symbol: "₾"},
+	"GHS": {Numeric: "936", MinorUnits: 2, Name: "Ghana Cedi"},
+	"GIP": {Numeric: "292", MinorUnits: 2, Name: "Gibraltar Pound"},
+	"GMD": {Numeric: "270", MinorUnits: 2, Name: "Dalasi"},
+	"GNF": {Numeric: "324", MinorUnits: 0, Name: "Guinean Franc"},
+	"GTQ": {Numeric: "320", MinorUnits: 2, Name: "Quetzal"},
+	"GYD": {Numeric: "328", MinorUnits: 2, Name: "Guyana Dollar"},
+	"HKD": {Numeric: "344", MinorUnits: 2, Name: "Hong Kong Dollar", Symbol: "HK$"},
+	"HNL": {Numeric: "340", MinorUnits: 2, Name: "Lempira"},
+	"HTG": {Numeric: "332", MinorUnits: 2, Name: "Gourde"},
+	"HUF": {Numeric: "348", MinorUnits: 2, Name: "Forint", Symbol: "Ft"},
+	"IDR": {Numeric: "360", MinorUnits: 2, Name: "Rupiah", Symbol: "Rp"},
+	"ILS": {Numeric: "376", MinorUnits: 2, Name: "New Israeli Sheqel", Symbol: "₪"},
+	"INR": {Numeric: "356", MinorUnits: 2, Name: "Indian Rupee", Symbol: "₹"},
+	"IQD": {Numeric: "368", MinorUnits: 3, Name: "Iraqi Dinar"},
+	"IRR": {Numeric: "364", MinorUnits: 2, Name: "Iranian Rial"},
+	"ISK": {Numeric: "352", MinorUnits: 0, Name: "Iceland Krona"},
+	"JMD": {Numeric: "388", MinorUnits: 2, Name: "Jamaican Dollar"},
+	"JOD": {Numeric: "400", MinorUnits: 3, Name: "Jordanian Dinar"},
+	"JPY": {Numeric: "392", MinorUnits: 0, Name: "Yen", Symbol: "¥"},
+	"KES": {Numeric: "404", MinorUnits: 2, Name: "Kenyan Shilling"},
+	"KGS": {Numeric: "417", MinorUnits: 2, Name: "Som"},
+	"KHR": {Numeric: "116", MinorUnits: 2, Name: "Riel"},
+	"KMF": {Numeric: "174", MinorUnits: 0, Name: "Comorian Franc"},
+	"KPW": {Numeric: "408", MinorUnits: 2, Name: "North Korean Won"},
+	"KRW": {Numeric: "410", MinorUnits: 0, Name: "Won", Symbol: "₩"},
+	"KWD": {Numeric: "414", MinorUnits: 3, Name: "Kuwaiti Dinar"},
+	"KYD": {Numeric: "136", MinorUnits: 2, Name: "Cayman Islands Dollar"},
+	"KZT": {Numeric: "398", MinorUnits: 2, Name: "Tenge", Symbol: "₸"},
+	"LAK": {Numeric: "418", MinorUnits: 2, Name: "Lao Kip"},
+	"LBP": {Numeric: "422", MinorUnits: 2, Name: "Lebanese Pound"},
+	"LKR": {Numeric: "144", MinorUnits: 2, Name: "Sri Lanka Rupee"},
+	"LRD": {Numeric: "430", MinorUnits: 2, Name: "Liberian Dollar"},
+	"LSL": {Numeric: "426", MinorUnits: 2, Name: "Loti"},
+	"LYD": {Numeric: "434", MinorUnits: 3, Name: "Libyan Dinar"},
+	"MAD": {Numeric: "504", MinorUnits: 2, Name: "Moroccan Dirham"},
+	"MDL": {Numeric: "498", MinorUnits: 2, Name: "Moldovan Leu"},
+	"MGA": {Numeric: "969", MinorUnits: 2, Name: "Malagasy Ariary"},
+	"MKD": {Numeric: "807", MinorUnits: 2, Name: "Denar"},
+	"MMK": {Numeric: "104", MinorUnits: 2, Name: "Kyat"},
+	"MNT": {Numeric: "496", MinorUnits: 2, Name: "Tugrik", Symbol: "₮"},
+	"MOP": {Numeric: "446", MinorUnits: 2, Name: "Pataca"},
+	"MRU": {Numeric: "929", MinorUnits: 2, Name: "Ouguiya"},
+	"MUR": {Numeric: "480", MinorUnits: 2, Name: "Mauritius Rupee"},
+	"MVR": {Numeric: "462", MinorUnits: 2, Name: "Rufiyaa"},
+	"MWK": {Numeric: "454", MinorUnits: 2, Name: "Malawi Kwacha"},
+	"MXN": {Numeric: "484", MinorUnits: 2, Name: "Mexican Peso", Symbol: "$"},
+	"MYR": {Numeric: "458", MinorUnits: 2, Name: "Malaysian Ringgit", Symbol: "RM"},
+	"MZN": {Numeric: "943", MinorUnits: 2, Name: "Mozambique Metical"},
+	"NAD": {Numeric: "516", MinorUnits: 2, Name: "Namibia Dollar"},
+	"NGN": {Numeric: "566", MinorUnits: 2, Name: "Naira", Symbol: "₦"},
+	"NIO": {Numeric: "558", MinorUnits: 2, Name: "Cordoba Oro"},
+	"NOK": {Numeric: "578", MinorUnits: 2, Name: "Norwegian Krone", Symbol: "kr"},
+	"NPR": {Numeric: "524", MinorUnits: 2, Name: "Nepalese Rupee"},
+	"NZD": {Numeric: "554", MinorUnits: 2, Name: "New Zealand Dollar", Symbol: "NZ$"},
+	"OMR": {Numeric: "512", MinorUnits: 3, Name: "Rial Omani"},
+	"PAB": {Numeric: "590", MinorUnits: 2, Name: "Balboa"},
+	"PEN": {Numeric: "604", MinorUnits: 2, Name: "Sol"},
+	"PGK": {Numeric: "598", MinorUnits: 2, Name: "Kina"},
+	"PHP": {Numeric: "608", MinorUnits: 2, Name: "Philippine Peso", Symbol: "₱"},
+	"PKR": {Numeric: "586", MinorUnits: 2, Name: "Pakistan Rupee"},
+	"PLN": {Numeric: "985", MinorUnits: 2, Name: "Zloty", Symbol: "zł"},
+	"PYG": {Numeric: "600", MinorUnits: 0, Name: "Guarani", Symbol: "₲"},
+	"QAR": {Numeric: "634", MinorUnits: 2, Name: "Qatari Rial"},
+	"RON": {Numeric: "946", MinorUnits: 2, Name: "Romanian Leu"},
+	"RSD": {Numeric: "941", MinorUnits: 2, Name: "Serbian Dinar"},
+	"RUB": {Numeric: "643", MinorUnits: 2, Name: "Russian Ruble", Symbol: "₽"},
+	"RWF": {Numeric: "646", MinorUnits: 0, Name: "Rwanda Franc"},
+	"SAR": {Numeric: "682", MinorUnits: 2, Name: "Saudi Riyal"},
+	"SBD": {Numeric: "090", MinorUnits: 2, Name: "Solomon Islands Dollar"},
+	"SCR": {Numeric: "690", MinorUnits: 2, Name: "Seychelles Rupee"},
+	"SDG": {Numeric: "938", MinorUnits: 2, Name: "Sudanese Pound"},
+	"SEK": {Numeric: "752", MinorUnits: 2, Name: "Swedish Krona", Symbol: "kr"},
+	"SGD": {Numeric: "702", MinorUnits: 2, Name: "Singapore Dollar", Symbol: "S$"},
+	"SHP": {Numeric: "654", MinorUnits: 2, Name: "Saint Helena Pound"},
+	"SLE": {Numeric: "925", MinorUnits: 2, Name: "Leone"},
+	"SOS": {Numeric: "706", MinorUnits: 2, Name: "Somali Shilling"},
+	"SRD": {Numeric: "968", MinorUnits: 2, Name: "Surinam Dollar"},
+	"SSP": {Numeric: "728", MinorUnits: 2, Name: "South Sudanese Pound"},
+	"STN": {Numeric: "930", MinorUnits: 2, Name: "Dobra"},
+	"SVC": {Numeric: "222", MinorUnits: 2, Name: "El Salvador Colon"},
+	"SYP": {Numeric: "760", MinorUnits: 2, Name: "Syrian Pound"},
+	"SZL": {Numeric: "748", MinorUnits: 2, Name: "Lilangeni"},
+	"THB": {Numeric: "764", MinorUnits: 2, Name: "Baht", Symbol: "฿"},
+	"TJS": {Numeric: "972", MinorUnits: 2, Name: "Somoni"},
+	"TMT": {Numeric: "934", MinorUnits: 2, Name: "Turkmenistan New Manat"},
+	"TND": {Numeric: "788", MinorUnits: 3, Name: "Tunisian Dinar"},
+	"TOP": {Numeric: "776", MinorUnits: 2, Name: "Pa'anga"},
+	"TRY": {Numeric: "949", MinorUnits: 2, Name: "Turkish Lira", Symbol: "₺"},
+	"TTD": {Numeric: "780", MinorUnits: 2, Name: "Trinidad and Tobago Dollar"},
+	"TWD": {Numeric: "901", MinorUnits: 2, Name: "New Taiwan Dollar", Symbol: "NT$"},
+	"TZS": {Numeric: "834", MinorUnits: 2, Name: "Tanzanian Shilling"},
+	"UAH": {Numeric: "980", MinorUnits: 2, Name: "Hryvnia", Symbol: "₴"},
+	"UGX": {Numeric: "800", MinorUnits: 0, Name: "Uganda Shilling"},
+	"USD": {Numeric: "840", MinorUnits: 2, Name: "US Dollar", Symbol: "$"},
+	"UYU": {Numeric: "858", MinorUnits: 2, Name: "Peso Uruguayo"},
+	"UZS": {Numeric: "860", MinorUnits: 2, Name: "Uzbekistan Sum"},
+	"VES": {Numeric: "928", MinorUnits: 2, Name: "Bolivar Soberano"},
+	"VND": {Numeric: "704", MinorUnits: 0, Name: "Dong", Symbol: "₫"},
+	"VUV": {Numeric: "548", MinorUnits: 0, Name: "Vatu"},
+	"WST": {Numeric: "882", MinorUnits: 2, Name: "Tala"},
+	"XAF": {Numeric: "950", MinorUnits: 0, Name: "CFA Franc BEAC"},
+	"XCD": {Numeric: "951", MinorUnits: 2, Name: "East Caribbean Dollar"},
+	"XOF": {Numeric: "952", MinorUnits: 0, Name: "CFA Franc BCEAO"},
+	"XPF": {Numeric: "953", MinorUnits: 0, Name: "CFP Franc"},
+	"YER": {Numeric: "886", MinorUnits: 2, Name: "Yemeni Rial"},
+	"ZAR": {Numeric: "710", MinorUnits: 2, Name: "Rand", Symbol: "R"},
+	"ZMW": {Numeric: "967", MinorUnits: 2, Name: "Zambian Kwacha"},
+	"ZWG": {Numeric: "924", MinorUnits: 2, Name: "Zimbabwe Gold"},
+}
@@ -0,0 +1,65 @@
+package currency
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Registry - currencies supported by deployment
+type Registry struct {
+	supported map[string]Currency
+}
+
+// NewRegistry - codes must be ISO 4217, rounding overrides default rounding mode per currency
+func NewRegistry(codes []string, rounding map[string]RoundingMode) (*Registry, error) {
+	if len(codes) == 0 {
+		return nil, fmt.Errorf("currency: no supported currencies")
+	}
+
+	supported := make(map[string]Currency, len(codes))
+	for _, code := range codes {
+		c, err := Lookup(code)
+		if err != nil {
+			return nil, err
+		}
+		supported[c.Code] = c
+	}
+
+	for code, mode := range rounding {
+		c, ok := supported[strings.ToUpper(code)]
+		if !ok {
+			return nil, fmt.Errorf("%w: rounding for %q", ErrUnsupportedCurrency, code)
+		}
+		if !mode.IsValid() {
+			return nil, fmt.Errorf("currency: invalid rounding mode %q for %q", mode, code)
+		}
+		c.Rounding = mode
+		supported[c.Code] = c
+	}
+
+	return &Registry{supported: supported}, nil
+}
+
+// Get - supported currency by code, code is case-insensitive
+func (r *Registry) Get(code string) (Currency, error) {
+	c, ok := r.supported[strings.ToUpper(code)]
+	if !ok {
+		return Currency{}, fmt.Errorf("%w: %q", ErrUnsupportedCurrency, code)
+	}
+
+	return c, nil
+}
+
+// Supported - all supported currencies ordered by code
+func (r *Registry) Supported() []Currency {
+	result := make([]Currency, 0, len(r.supported))
+	for _, c := range r.supported {
+		result = append(result, c)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Code < result[j].Code
+	})
+	return result
+}
@@ -0,0 +1,62 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kafka topics of account service
+const (
+	TopicAccountCreated       = "AccountCreated"
+	TopicAccountStatusChanged = "AccountStatusChanged"
+	TopicAccountOverdraft     = "AccountOverdraft"
+)
+
+// Event types of TopicAccountStatusChanged, one per lifecycle transition
+const (
+	AccountFrozen    = "account_frozen"
+	AccountUnfrozen  = "account_unfrozen"
+	AccountBlocked   = "account_blocked"
+	AccountUnblocked = "account_unblocked"
+	AccountClosed    = "account_closed"
+)
+
+// Event types of TopicAccountOverdraft
+const (
+	OverdraftEntered = "overdraft_entered"
+	OverdraftLeft    = "overdraft_left"
+)
+
+// AccountCreated - payload of TopicAccountCreated, key is owner id
+type AccountCreated struct {
+	AccountNumber string    `json:"account_number"`
+	OwnerID       uuid.UUID `json:"owner_id"`
+	Name          string    `json:"name"`
+	Currency      string    `json:"currency"`
+	Email         string    `json:"email"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// AccountStatusChanged - payload of TopicAccountStatusChanged, key is account number
+type AccountStatusChanged struct {
+	EventType     string    `json:"event_type"`
+	AccountNumber string    `json:"account_number"`
+	OwnerID       uuid.UUID `json:"owner_id"`
+	FromStatus    string    `json:"from_status"`
+	ToStatus      string    `json:"to_status"`
+	ReasonCode    string    `json:"reason_code"`
+	ChangedBy     string    `json:"changed_by"`
+	ChangedAt     time.Time `json:"changed_at"`
+}
+
+// AccountOverdraft - payload of TopicAccountOverdraft, sent when balance crosses zero, key is account number
+type AccountOverdraft struct {
+	EventType      string    `json:"event_type"`
+	AccountNumber  string    `json:"account_number"`
+	OwnerID        uuid.UUID `json:"owner_id"`
+	Currency       string    `json:"currency"`
+	Balance        string    `json:"balance"`
+	OverdraftLimit string    `json:"overdraft_limit"`
+	OccurredAt     time.Time `json:"occurred_at"`
+}
@@ -0,0 +1,38 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kafka topics of payment service
+const (
+	TopicScheduledPayment = "ScheduledPayment"
+)
+
+// Event types of TopicScheduledPayment, one per execution outcome
+const (
+	ScheduledPaymentExecuted       = "scheduled_payment_executed"
+	ScheduledPaymentRetryScheduled = "scheduled_payment_retry_scheduled"
+	ScheduledPaymentFailed         = "scheduled_payment_failed"
+	ScheduledPaymentPendingReview  = "scheduled_payment_pending_review"
+)
+
+// ScheduledPayment - payload of TopicScheduledPayment, key is schedule id
+type ScheduledPayment struct {
+	EventType     string     `json:"event_type"`
+	ScheduleID    uuid.UUID  `json:"schedule_id"`
+	OwnerID       uuid.UUID  `json:"owner_id"`
+	From          string     `json:"from"`
+	To            string     `json:"to"`
+	Amount        string     `json:"amount"`
+	Currency      string     `json:"currency"`
+	ScheduledFor  time.Time  `json:"scheduled_for"`
+	Attempt       int        `json:"attempt"`
+	TransactionID *uuid.UUID `json:"transaction_id,omitempty"`
+	PaymentID     *uuid.UUID `json:"payment_id,omitempty"`
+	NextRunAt     *time.Time `json:"next_run_at,omitempty"`
+	Reason        string     `json:"reason,omitempty"`
+	OccurredAt    time.Time  `json:"occurred_at"`
+}
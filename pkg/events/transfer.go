@@ -0,0 +1,26 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kafka topics of money movements
+const (
+	TopicTransferCompleted = "TransferCompleted"
+)
+
+// TransferCompleted - payload of TopicTransferCompleted, key is source account number
+type TransferCompleted struct {
+	TransactionID  uuid.UUID `json:"transaction_id"`
+	From           string    `json:"from"`
+	To             string    `json:"to"`
+	DebitAmount    string    `json:"debit_amount"`
+	DebitCurrency  string    `json:"debit_currency"`
+	CreditAmount   string    `json:"credit_amount"`
+	CreditCurrency string    `json:"credit_currency"`
+	FXRate         string    `json:"fx_rate,omitempty"`
+	InitiatedBy    string    `json:"initiated_by"`
+	CreatedAt      time.Time `json:"created_at"`
+}
@@ -0,0 +1,89 @@
+package httpclient
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const timeoutDefault = 5 * time.Second
+
+// TokenSource - smths that gives us a bearer token for outgoing requests
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+type clientOptions struct {
+	timeout     time.Duration
+	tokenSource TokenSource
+	tlsConfig   *tls.Config
+}
+
+type ClientOption func(options *clientOptions)
+
+// WithTimeout ...
+func WithTimeout(d time.Duration) ClientOption {
+	return func(opts *clientOptions) {
+		opts.timeout = d
+	}
+}
+
+// WithTokenSource - attach token from ts to every request as "Authorization: Bearer"
+func WithTokenSource(ts TokenSource) ClientOption {
+	return func(opts *clientOptions) {
+		opts.tokenSource = ts
+	}
+}
+
+// WithTLS - use cfg for outgoing connections (e.g. client certificates for mTLS)
+func WithTLS(cfg *tls.Config) ClientOption {
+	return func(opts *clientOptions) {
+		opts.tlsConfig = cfg
+	}
+}
+
+// New - returns http.Client for service-to-service calls
+func New(opts ...ClientOption) *http.Client {
+	options := &clientOptions{
+		timeout: timeoutDefault,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	if options.tlsConfig != nil {
+		base.TLSClientConfig = options.tlsConfig
+	}
+
+	var transport http.RoundTripper = base
+	if options.tokenSource != nil {
+		transport = &authTransport{base: base, tokenSource: options.tokenSource}
+	}
+
+	return &http.Client{
+		Timeout:   options.timeout,
+		Transport: transport,
+	}
+}
+
+// authTransport - sets Authorization header from tokenSource
+type authTransport struct {
+	base        http.RoundTripper
+	tokenSource TokenSource
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.tokenSource.Token(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("httpclient: get token: %w", err)
+	}
+
+	// RoundTripper must not modify the original request
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,134 @@
+package iban
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// AccountDigits - length of account part of BBAN
+const AccountDigits = 10
+
+var (
+	ErrInvalidLength      = errors.New("iban: invalid length")
+	ErrInvalidCharacters  = errors.New("iban: invalid characters")
+	ErrInvalidCheckDigits = errors.New("iban: invalid check digits")
+)
+
+var ninetySeven = big.NewInt(97)
+
+// Generator - makes IBAN-style numbers: country code, 2 check digits, bank code, random account digits
+type Generator struct {
+	countryCode string
+	bankCode    string
+}
+
+// NewGenerator - countryCode is 2 letters, bankCode is alphanumeric
+func NewGenerator(countryCode, bankCode string) (*Generator, error) {
+	countryCode, bankCode = strings.ToUpper(countryCode), strings.ToUpper(bankCode)
+
+	if len(countryCode) != 2 || !isLetters(countryCode) {
+		return nil, fmt.Errorf("iban: invalid country code %q", countryCode)
+	}
+	if bankCode == "" || !isAlphanumeric(bankCode) {
+		return nil, fmt.Errorf("iban: invalid bank code %q", bankCode)
+	}
+	if 4+len(bankCode)+AccountDigits > 34 {
+		return nil, fmt.Errorf("iban: bank code %q is too long", bankCode)
+	}
+
+	return &Generator{countryCode: countryCode, bankCode: bankCode}, nil
+}
+
+// Generate - new random number, uniqueness must be checked by caller
+func (g *Generator) Generate() (string, error) {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(AccountDigits), nil)
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", fmt.Errorf("iban: generate account digits: %w", err)
+	}
+
+	bban := g.bankCode + fmt.Sprintf("%0*s", AccountDigits, n.String())
+
+	return g.countryCode + checkDigits(g.countryCode, bban) + bban, nil
+}
+
+// Normalize - removes spaces and upper-cases
+func Normalize(s string) string {
+	return strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+}
+
+// Validate - checks format and ISO 7064 MOD 97-10 check digits of normalized number
+func Validate(s string) error {
+	if len(s) < 5 || len(s) > 34 {
+		return ErrInvalidLength
+	}
+	if !isLetters(s[:2]) || !isDigits(s[2:4]) || !isAlphanumeric(s[4:]) {
+		return ErrInvalidCharacters
+	}
+	if mod97(s[4:]+s[:4]) != 1 {
+		return ErrInvalidCheckDigits
+	}
+
+	return nil
+}
+
+// Format - groups of four characters for display
+func Format(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if i > 0 && i%4 == 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func checkDigits(countryCode, bban string) string {
+	return fmt.Sprintf("%02d", 98-mod97(bban+countryCode+"00"))
+}
+
+// mod97 - letters are replaced with numbers A=10 ... Z=35
+func mod97(s string) int64 {
+	var digits strings.Builder
+	for _, r := range s {
+		if r >= 'A' && r <= 'Z' {
+			digits.WriteString(fmt.Sprint(r - 'A' + 10))
+			continue
+		}
+		digits.WriteRune(r)
+	}
+
+	n, _ := new(big.Int).SetString(digits.String(), 10)
+	return new(big.Int).Mod(n, ninetySeven).Int64()
+}
+
+func isLetters(s string) bool {
+	for _, r := range s {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphanumeric(s string) bool {
+	for _, r := range s {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,72 @@
+package kafka
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/R1ckNash/Bank/pkg/resilience"
+)
+
+const (
+	// breaker opens after consecutive failed sends, then sends fail fast instead of waiting for broker timeouts
+	breakerThreshold = 5
+	breakerCooldown  = 30 * time.Second
+	// sends blocked on kafka at the same time, the rest fail immediately
+	bulkheadSize = 64
+)
+
+// Producer - sync producer with messages keyed for hash partitioning, sends go through "kafka"
+// circuit breaker and bulkhead (see resilience.RegisterMetrics for their state)
+type Producer struct {
+	producer sarama.SyncProducer
+	breaker  *resilience.Breaker
+	bulkhead *resilience.Bulkhead
+}
+
+func NewProducer(brokers []string) (*Producer, error) {
+	config := sarama.NewConfig()
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Return.Successes = true
+	config.Producer.Partitioner = sarama.NewHashPartitioner
+
+	prod, err := sarama.NewSyncProducer(brokers, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Producer{
+		producer: prod,
+		breaker: resilience.NewBreaker(resilience.BreakerSettings{
+			Name:             "kafka",
+			FailureThreshold: breakerThreshold,
+			OpenTimeout:      breakerCooldown,
+		}),
+		bulkhead: resilience.NewBulkhead("kafka", bulkheadSize, 0),
+	}, nil
+}
+
+// Send - sends message and waits for all in-sync replicas, returns partition and offset of message
+func (p *Producer) Send(topic, key string, message []byte) (int32, int64, error) {
+	msg := &sarama.ProducerMessage{
+		Topic: topic,
+		Key:   sarama.StringEncoder(key),
+		Value: sarama.ByteEncoder(message),
+	}
+
+	var partition int32
+	var offset int64
+	err := p.bulkhead.Execute(context.Background(), func(ctx context.Context) error {
+		return p.breaker.Execute(ctx, func(context.Context) error {
+			var err error
+			partition, offset, err = p.producer.SendMessage(msg)
+			return err
+		})
+	})
+
+	return partition, offset, err
+}
+
+func (p *Producer) Close() error {
+	return p.producer.Close()
+}
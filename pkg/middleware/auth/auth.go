@@ -2,21 +2,32 @@ package auth
 
 import (
 	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
 	"github.com/golang-jwt/jwt/v5"
 	"net/http"
+	"slices"
 	"strings"
 )
 
 type contextKey string
 
-const userIDContextKey = contextKey("userID")
+const (
+	userIDContextKey = contextKey("userID")
+	roleContextKey   = contextKey("role")
+)
+
+// User roles, carried in "role" claim of user token
+const (
+	RoleCustomer = "customer"
+	RoleOperator = "operator"
+)
 
 func AuthMiddleware(secret string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			authHeader := r.Header.Get("Authorization")
 			if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
-				http.Error(w, `{"error": "missing or invalid token"}`, http.StatusUnauthorized)
+				problem.Write(w, r, problem.Unauthorized.New("missing or invalid token"))
 				return
 			}
 
@@ -30,23 +41,30 @@ func AuthMiddleware(secret string) func(http.Handler) http.Handler {
 			})
 
 			if err != nil || !token.Valid {
-				http.Error(w, `{"error": "invalid token"}`, http.StatusUnauthorized)
+				problem.Write(w, r, problem.Unauthorized.New("invalid token"))
 				return
 			}
 
 			claims, ok := token.Claims.(jwt.MapClaims)
 			if !ok {
-				http.Error(w, `{"error": "invalid claims"}`, http.StatusUnauthorized)
+				problem.Write(w, r, problem.Unauthorized.New("invalid claims"))
 				return
 			}
 
 			userID, ok := claims["user_id"].(string)
 			if !ok {
-				http.Error(w, `{"error": "user_id not found in token"}`, http.StatusUnauthorized)
+				problem.Write(w, r, problem.Unauthorized.New("user_id not found in token"))
 				return
 			}
 
+			// tokens issued before roles were introduced belong to customers
+			role, ok := claims["role"].(string)
+			if !ok {
+				role = RoleCustomer
+			}
+
 			ctx := context.WithValue(r.Context(), userIDContextKey, userID)
+			ctx = context.WithValue(ctx, roleContextKey, role)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
@@ -57,3 +75,24 @@ func GetUserID(r *http.Request) (string, bool) {
 	userID, ok := val.(string)
 	return userID, ok
 }
+
+func GetRole(r *http.Request) (string, bool) {
+	val := r.Context().Value(roleContextKey)
+	role, ok := val.(string)
+	return role, ok
+}
+
+// RequireRole - rejects users without one of roles. Must be used after AuthMiddleware
+func RequireRole(roles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			role, _ := GetRole(r)
+			if !slices.Contains(roles, role) {
+				problem.Write(w, r, problem.Forbidden.New("forbidden"))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ServiceTokenType - value of the "typ" claim for client-credentials tokens
+const ServiceTokenType = "service"
+
+const (
+	serviceIDContextKey     = contextKey("serviceID")
+	serviceScopesContextKey = contextKey("serviceScopes")
+)
+
+// ServiceAuthMiddleware - accepts only service (client-credentials) tokens signed with secret
+func ServiceAuthMiddleware(secret string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authHeader := r.Header.Get("Authorization")
+			if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
+				problem.Write(w, r, problem.Unauthorized.New("missing or invalid token"))
+				return
+			}
+
+			tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
+
+			token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
+				if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+					return nil, jwt.ErrSignatureInvalid
+				}
+				return []byte(secret), nil
+			})
+
+			if err != nil || !token.Valid {
+				problem.Write(w, r, problem.Unauthorized.New("invalid token"))
+				return
+			}
+
+			claims, ok := token.Claims.(jwt.MapClaims)
+			if !ok {
+				problem.Write(w, r, problem.Unauthorized.New("invalid claims"))
+				return
+			}
+
+			if typ, _ := claims["typ"].(string); typ != ServiceTokenType {
+				problem.Write(w, r, problem.Unauthorized.New("service token required"))
+				return
+			}
+
+			serviceID, err := claims.GetSubject()
+			if err != nil || serviceID == "" {
+				problem.Write(w, r, problem.Unauthorized.New("sub not found in token"))
+				return
+			}
+
+			scope, _ := claims["scope"].(string)
+
+			ctx := context.WithValue(r.Context(), serviceIDContextKey, serviceID)
+			ctx = context.WithValue(ctx, serviceScopesContextKey, strings.Fields(scope))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequireScope - rejects service requests whose token doesn't carry scope.
+// Must be used after ServiceAuthMiddleware
+func RequireScope(scope string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			scopes, _ := r.Context().Value(serviceScopesContextKey).([]string)
+			if !slices.Contains(scopes, scope) {
+				problem.Write(w, r, problem.Forbidden.New("insufficient scope"))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func GetServiceID(r *http.Request) (string, bool) {
+	val := r.Context().Value(serviceIDContextKey)
+	serviceID, ok := val.(string)
+	return serviceID, ok
+}
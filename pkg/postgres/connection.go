@@ -8,8 +8,13 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
 	"time"
 
+	pgxDecimal "github.com/jackc/pgx-shopspring-decimal"
 	pgxUUID "github.com/vgarvardt/pgx-google-uuid/v5"
 )
 
@@ -18,6 +23,9 @@ const (
 	maxConnLifeTimeDefault     = time.Hour
 	minConnectionsCountDefault = 2
 	maxConnectionsCountDefault = 10
+	healthCheckPeriodDefault   = 5 * time.Second
+
+	primaryPool = "primary"
 )
 
 type connectionPoolOptions struct {
@@ -26,6 +34,14 @@ type connectionPoolOptions struct {
 	minConnectionsCount int32
 	maxConnectionsCount int32
 	tlsConfig           *tls.Config
+	replicaConnStrings  []string
+	healthCheckPeriod   time.Duration
+
+	metricsRegisterer  prometheus.Registerer
+	queryDuration      *prometheus.HistogramVec
+	slowQueryLogger    *slog.Logger
+	slowQueryThreshold time.Duration
+	tracer             trace.Tracer
 }
 
 type ConnectionPoolOption func(options *connectionPoolOptions)
@@ -65,41 +81,147 @@ func WithSSL(cfg *tls.Config) ConnectionPoolOption {
 	}
 }
 
-// Connection - postgres connection pool
-type Connection struct {
-	pool *pgxpool.Pool
+// WithReplicas - read replicas, read queries outside of transaction and read only transactions are sent to them
+func WithReplicas(connStrings ...string) ConnectionPoolOption {
+	return func(opts *connectionPoolOptions) {
+		opts.replicaConnStrings = connStrings
+	}
 }
 
-// NewConnectionPool - returns new Connection (connection pool for postgres)
-func NewConnectionPool(ctx context.Context, connString string, opts ...ConnectionPoolOption) (*Connection, error) {
-	// parse connString
-	connConfig, err := pgxpool.ParseConfig(connString)
-	if err != nil {
-		return nil, fmt.Errorf("can't parse connection string to config: %w", err)
+// WithReplicaHealthCheckPeriod - how often replicas are pinged, replica that didn't respond is skipped
+func WithReplicaHealthCheckPeriod(d time.Duration) ConnectionPoolOption {
+	return func(opts *connectionPoolOptions) {
+		opts.healthCheckPeriod = d
 	}
+}
 
-	connConfig.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
-		pgxUUID.Register(conn.TypeMap())
-		return nil
+// WithMetrics - query duration histogram by query name (see WithQueryName) and pool statistics registered in reg
+func WithMetrics(reg prometheus.Registerer) ConnectionPoolOption {
+	return func(opts *connectionPoolOptions) {
+		opts.metricsRegisterer = reg
 	}
+}
 
+// WithSlowQueryLog - queries that took threshold or longer are logged with warn level
+func WithSlowQueryLog(logger *slog.Logger, threshold time.Duration) ConnectionPoolOption {
+	return func(opts *connectionPoolOptions) {
+		opts.slowQueryLogger = logger
+		opts.slowQueryThreshold = threshold
+	}
+}
+
+// WithTracing - OpenTelemetry spans for queries and transactions, nil provider means global one
+func WithTracing(provider trace.TracerProvider) ConnectionPoolOption {
+	return func(opts *connectionPoolOptions) {
+		if provider == nil {
+			provider = otel.GetTracerProvider()
+		}
+		opts.tracer = provider.Tracer(tracerName)
+	}
+}
+
+// Connection - postgres connection pool of primary and optional replicas
+type Connection struct {
+	pool     *pgxpool.Pool
+	replicas *replicaSet
+	tracer   *queryTracer
+}
+
+// NewConnectionPool - returns new Connection (connection pool for postgres)
+func NewConnectionPool(ctx context.Context, connString string, opts ...ConnectionPoolOption) (*Connection, error) {
 	// make options
 	options := &connectionPoolOptions{
 		maxConnIdleTime:     maxConnIdleTimeDefault,
 		maxConnLifeTime:     maxConnLifeTimeDefault,
 		minConnectionsCount: minConnectionsCountDefault,
 		maxConnectionsCount: maxConnectionsCountDefault,
+		healthCheckPeriod:   healthCheckPeriodDefault,
 	}
 	for _, opt := range opts {
 		opt(options)
 	}
 
+	if options.metricsRegisterer != nil {
+		options.queryDuration = newQueryDuration()
+		if err := options.metricsRegisterer.Register(options.queryDuration); err != nil {
+			return nil, fmt.Errorf("can't register query metrics: %w", err)
+		}
+	}
+
+	p, err := newPool(ctx, connString, options, primaryPool)
+	if err != nil {
+		return nil, err
+	}
+
+	// ping database
+	if err := p.Ping(ctx); err != nil {
+		p.Close()
+		return nil, fmt.Errorf("ping database error: %w", err)
+	}
+
+	connection := &Connection{pool: p, tracer: options.queryTracer(primaryPool)}
+	if len(options.replicaConnStrings) > 0 {
+		connection.replicas, err = newReplicaSet(ctx, options)
+		if err != nil {
+			p.Close()
+			return nil, err
+		}
+	}
+
+	if options.metricsRegisterer != nil {
+		collector := &poolCollector{pools: map[string]*pgxpool.Pool{primaryPool: p}}
+		if connection.replicas != nil {
+			for _, r := range connection.replicas.replicas {
+				collector.pools[r.name] = r.pool
+			}
+		}
+
+		if err = options.metricsRegisterer.Register(collector); err != nil {
+			_ = connection.Close()
+			return nil, fmt.Errorf("can't register pool metrics: %w", err)
+		}
+	}
+
+	return connection, nil
+}
+
+// queryTracer - nil when neither metrics, slow query log nor tracing are enabled
+func (o *connectionPoolOptions) queryTracer(pool string) *queryTracer {
+	if o.queryDuration == nil && o.slowQueryLogger == nil && o.tracer == nil {
+		return nil
+	}
+
+	return &queryTracer{
+		pool:          pool,
+		duration:      o.queryDuration,
+		logger:        o.slowQueryLogger,
+		slowThreshold: o.slowQueryThreshold,
+		tracer:        o.tracer,
+	}
+}
+
+func newPool(ctx context.Context, connString string, options *connectionPoolOptions, name string) (*pgxpool.Pool, error) {
+	// parse connString
+	connConfig, err := pgxpool.ParseConfig(connString)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse connection string to config: %w", err)
+	}
+
+	connConfig.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+		pgxUUID.Register(conn.TypeMap())
+		pgxDecimal.Register(conn.TypeMap())
+		return nil
+	}
+
 	// apply options
 	connConfig.MaxConnIdleTime = options.maxConnIdleTime
 	connConfig.MaxConnLifetime = options.maxConnLifeTime
 	connConfig.MinConns = options.minConnectionsCount
 	connConfig.MaxConns = options.maxConnectionsCount
 	connConfig.ConnConfig.Config.TLSConfig = options.tlsConfig
+	if tracer := options.queryTracer(name); tracer != nil {
+		connConfig.ConnConfig.Tracer = tracer
+	}
 
 	// connect to database
 	p, err := pgxpool.NewWithConfig(ctx, connConfig)
@@ -107,24 +229,20 @@ func NewConnectionPool(ctx context.Context, connString string, opts ...Connectio
 		return nil, fmt.Errorf("can't connect to database: %w", err)
 	}
 
-	// ping database
-	if err := p.Ping(ctx); err != nil {
-		return nil, fmt.Errorf("ping database error: %w", err)
-	}
-
-	return &Connection{
-		pool: p,
-	}, nil
+	return p, nil
 }
 
 func (c *Connection) Close() error {
+	if c.replicas != nil {
+		c.replicas.close()
+	}
 	c.pool.Close()
 	return nil
 }
 
 // Query - pgx.Query
 func (c *Connection) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
-	return c.pool.Query(ctx, sql, args...)
+	return c.reader(ctx, sql).Query(ctx, sql, args...)
 }
 
 // Query - pgx.Exec
@@ -134,7 +252,7 @@ func (c *Connection) Exec(ctx context.Context, sql string, args ...interface{})
 
 // Query - pgx.QueryRow
 func (c *Connection) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
-	return c.pool.QueryRow(ctx, sql, args...)
+	return c.reader(ctx, sql).QueryRow(ctx, sql, args...)
 }
 
 // Begin - pgx.Begin
@@ -143,16 +261,24 @@ func (c *Connection) Begin(ctx context.Context) (*Transaction, error) {
 	if err != nil {
 		return nil, fmt.Errorf("postgres: %w", err)
 	}
-	return &Transaction{tx}, nil
+	return &Transaction{Tx: tx}, nil
 }
 
-// BeginTx - pgx.BeginTx
+// BeginTx - pgx.BeginTx, read only transaction is started on replica
 func (c *Connection) BeginTx(ctx context.Context, txOptions pgx.TxOptions) (*Transaction, error) {
-	tx, err := c.pool.BeginTx(ctx, txOptions)
+	pool := c.pool
+	if txOptions.AccessMode == pgx.ReadOnly {
+		pool = c.replica(ctx)
+	}
+
+	ctx, span := c.tracer.startTransactionSpan(ctx, txOptions)
+
+	tx, err := pool.BeginTx(ctx, txOptions)
 	if err != nil {
+		endSpan(span, err)
 		return nil, fmt.Errorf("postgres: %w", err)
 	}
-	return &Transaction{tx}, nil
+	return &Transaction{Tx: tx, span: span}, nil
 }
 
 // SendBatch - pgx.SendBatch
@@ -177,7 +303,7 @@ func (c *Connection) Getx(ctx context.Context, dest interface{}, sqlizer Sqlizer
 		return fmt.Errorf("postgres: to sql: %w", err)
 	}
 
-	return pgxscan.Get(ctx, c.pool, dest, query, args...)
+	return pgxscan.Get(ctx, c.reader(ctx, query), dest, query, args...)
 }
 
 // Selectx - aka Query
@@ -187,7 +313,7 @@ func (c *Connection) Selectx(ctx context.Context, dest interface{}, sqlizer Sqli
 		return fmt.Errorf("postgres: to sql: %w", err)
 	}
 
-	return pgxscan.Select(ctx, c.pool, dest, query, args...)
+	return pgxscan.Select(ctx, c.reader(ctx, query), dest, query, args...)
 }
 
 // Execx - aka Exec
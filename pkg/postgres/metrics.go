@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func newQueryDuration() *prometheus.HistogramVec {
+	return prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "db_query_duration_seconds",
+			Help:    "Duration of database queries by name.",
+			Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+		},
+		[]string{"pool", "query", "status"},
+	)
+}
+
+var (
+	poolAcquiredDesc = prometheus.NewDesc("db_pool_acquired_connections",
+		"Connections currently acquired from pool.", []string{"pool"}, nil)
+	poolIdleDesc = prometheus.NewDesc("db_pool_idle_connections",
+		"Idle connections in pool.", []string{"pool"}, nil)
+	poolTotalDesc = prometheus.NewDesc("db_pool_total_connections",
+		"Connections in pool.", []string{"pool"}, nil)
+	poolMaxDesc = prometheus.NewDesc("db_pool_max_connections",
+		"Max size of pool.", []string{"pool"}, nil)
+	poolAcquiresDesc = prometheus.NewDesc("db_pool_acquires_total",
+		"Connections acquired from pool.", []string{"pool"}, nil)
+	poolEmptyAcquiresDesc = prometheus.NewDesc("db_pool_empty_acquires_total",
+		"Acquires that waited for connection as pool was empty.", []string{"pool"}, nil)
+	poolAcquireWaitDesc = prometheus.NewDesc("db_pool_empty_acquire_wait_seconds_total",
+		"Time spent waiting for connection when pool was empty.", []string{"pool"}, nil)
+)
+
+// poolCollector - pool statistics, collected on scrape
+type poolCollector struct {
+	pools map[string]*pgxpool.Pool
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- poolAcquiredDesc
+	ch <- poolIdleDesc
+	ch <- poolTotalDesc
+	ch <- poolMaxDesc
+	ch <- poolAcquiresDesc
+	ch <- poolEmptyAcquiresDesc
+	ch <- poolAcquireWaitDesc
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	for name, p := range c.pools {
+		stat := p.Stat()
+		ch <- prometheus.MustNewConstMetric(poolAcquiredDesc, prometheus.GaugeValue, float64(stat.AcquiredConns()), name)
+		ch <- prometheus.MustNewConstMetric(poolIdleDesc, prometheus.GaugeValue, float64(stat.IdleConns()), name)
+		ch <- prometheus.MustNewConstMetric(poolTotalDesc, prometheus.GaugeValue, float64(stat.TotalConns()), name)
+		ch <- prometheus.MustNewConstMetric(poolMaxDesc, prometheus.GaugeValue, float64(stat.MaxConns()), name)
+		ch <- prometheus.MustNewConstMetric(poolAcquiresDesc, prometheus.CounterValue, float64(stat.AcquireCount()), name)
+		ch <- prometheus.MustNewConstMetric(poolEmptyAcquiresDesc, prometheus.CounterValue, float64(stat.EmptyAcquireCount()), name)
+		ch <- prometheus.MustNewConstMetric(poolAcquireWaitDesc, prometheus.CounterValue, stat.EmptyAcquireWaitTime().Seconds(), name)
+	}
+}
@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type primaryKey struct{}
+
+// WithPrimary - queries with returned ctx are sent to primary, e.g. reads right after write
+// that replica may not have received yet (read-your-writes)
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+func isPrimaryForced(ctx context.Context) bool {
+	forced, _ := ctx.Value(primaryKey{}).(bool)
+	return forced
+}
+
+// lockingClauses - select with them takes row locks, so it must run on primary
+var lockingClauses = []string{"FOR UPDATE", "FOR NO KEY UPDATE", "FOR SHARE", "FOR KEY SHARE"}
+
+// isReadQuery - plain SELECT, anything else (INSERT ... RETURNING, WITH, locking SELECT) may write
+func isReadQuery(sql string) bool {
+	sql = strings.ToUpper(strings.TrimSpace(sql))
+	if !strings.HasPrefix(sql, "SELECT") {
+		return false
+	}
+
+	for _, clause := range lockingClauses {
+		if strings.Contains(sql, clause) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// reader - pool for sql outside of transaction
+func (c *Connection) reader(ctx context.Context, sql string) *pgxpool.Pool {
+	if !isReadQuery(sql) {
+		return c.pool
+	}
+
+	return c.replica(ctx)
+}
+
+// replica - next healthy replica, primary when there are none or primary is forced
+func (c *Connection) replica(ctx context.Context) *pgxpool.Pool {
+	if c.replicas == nil || isPrimaryForced(ctx) {
+		return c.pool
+	}
+
+	if p := c.replicas.next(); p != nil {
+		return p
+	}
+
+	return c.pool
+}
+
+type replica struct {
+	name    string
+	pool    *pgxpool.Pool
+	healthy atomic.Bool
+}
+
+// replicaSet - replicas picked by round robin, health check skips ones that don't respond to ping
+type replicaSet struct {
+	replicas []*replica
+	counter  atomic.Uint64
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newReplicaSet(ctx context.Context, options *connectionPoolOptions) (*replicaSet, error) {
+	set := &replicaSet{stop: make(chan struct{})}
+
+	for i, connString := range options.replicaConnStrings {
+		name := fmt.Sprintf("replica-%d", i)
+		p, err := newPool(ctx, connString, options, name)
+		if err != nil {
+			set.closePools()
+			return nil, fmt.Errorf("replica: %w", err)
+		}
+
+		// replica that is down on start is used once it passes health check
+		r := &replica{name: name, pool: p}
+		r.healthy.Store(p.Ping(ctx) == nil)
+		set.replicas = append(set.replicas, r)
+	}
+
+	set.wg.Add(1)
+	go set.healthCheck(options.healthCheckPeriod)
+
+	return set, nil
+}
+
+func (s *replicaSet) next() *pgxpool.Pool {
+	n := uint64(len(s.replicas))
+	start := s.counter.Add(1)
+
+	for i := uint64(0); i < n; i++ {
+		if r := s.replicas[(start+i)%n]; r.healthy.Load() {
+			return r.pool
+		}
+	}
+
+	return nil
+}
+
+func (s *replicaSet) healthCheck(period time.Duration) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			for _, r := range s.replicas {
+				ctx, cancel := context.WithTimeout(context.Background(), period)
+				r.healthy.Store(r.pool.Ping(ctx) == nil)
+				cancel()
+			}
+		}
+	}
+}
+
+func (s *replicaSet) close() {
+	close(s.stop)
+	s.wg.Wait()
+	s.closePools()
+}
+
+func (s *replicaSet) closePools() {
+	for _, r := range s.replicas {
+		r.pool.Close()
+	}
+}
@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/R1ckNash/Bank/pkg/postgres"
+
+type queryNameKey struct{}
+
+// WithQueryName - name of queries with returned ctx in metrics, logs and spans,
+// by default it's made of statement and table, e.g. "select accounts"
+func WithQueryName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, queryNameKey{}, name)
+}
+
+// queryName - name from ctx or "<statement> <table>" of sql, raw sql is never used as it's unbounded
+func queryName(ctx context.Context, sql string) string {
+	if name, ok := ctx.Value(queryNameKey{}).(string); ok {
+		return name
+	}
+
+	fields := strings.Fields(strings.ToLower(sql))
+	if len(fields) == 0 {
+		return "unknown"
+	}
+
+	statement := fields[0]
+	var tableAfter string
+	switch statement {
+	case "select", "delete":
+		tableAfter = "from"
+	case "insert":
+		tableAfter = "into"
+	case "update":
+		if len(fields) > 1 {
+			return statement + " " + fields[1]
+		}
+	}
+
+	for i, field := range fields {
+		if field == tableAfter && i+1 < len(fields) {
+			return statement + " " + strings.Trim(fields[i+1], `"(`)
+		}
+	}
+
+	return statement
+}
+
+// queryTracer - pgx tracer feeding query duration histogram, slow query log and OpenTelemetry spans
+type queryTracer struct {
+	pool string
+
+	duration *prometheus.HistogramVec
+
+	logger        *slog.Logger
+	slowThreshold time.Duration
+
+	tracer trace.Tracer
+}
+
+type queryTrace struct {
+	name  string
+	sql   string
+	start time.Time
+	span  trace.Span
+}
+
+type queryTraceKey struct{}
+
+func (t *queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	qt := &queryTrace{
+		name:  queryName(ctx, data.SQL),
+		sql:   data.SQL,
+		start: time.Now(),
+	}
+
+	if t.tracer != nil {
+		ctx, qt.span = t.tracer.Start(ctx, qt.name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				attribute.String("db.system", "postgresql"),
+				attribute.String("db.statement", data.SQL),
+				attribute.String("db.pool", t.pool),
+			),
+		)
+	}
+
+	return context.WithValue(ctx, queryTraceKey{}, qt)
+}
+
+func (t *queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	qt, ok := ctx.Value(queryTraceKey{}).(*queryTrace)
+	if !ok {
+		return
+	}
+
+	elapsed := time.Since(qt.start)
+
+	status := "ok"
+	if data.Err != nil {
+		status = "error"
+	}
+
+	if t.duration != nil {
+		t.duration.WithLabelValues(t.pool, qt.name, status).Observe(elapsed.Seconds())
+	}
+
+	if t.logger != nil && elapsed >= t.slowThreshold {
+		t.logger.Warn("slow query",
+			slog.String("pool", t.pool),
+			slog.String("query", qt.name),
+			slog.Duration("duration", elapsed),
+			slog.String("sql", qt.sql),
+		)
+	}
+
+	if qt.span != nil {
+		if data.Err != nil {
+			qt.span.RecordError(data.Err)
+			qt.span.SetStatus(codes.Error, data.Err.Error())
+		} else {
+			qt.span.SetAttributes(attribute.Int64("db.rows_affected", data.CommandTag.RowsAffected()))
+		}
+		qt.span.End()
+	}
+}
+
+// startTransactionSpan - span covering transaction from begin to commit or rollback
+func (t *queryTracer) startTransactionSpan(ctx context.Context, txOptions pgx.TxOptions) (context.Context, trace.Span) {
+	if t == nil || t.tracer == nil {
+		return ctx, nil
+	}
+
+	return t.tracer.Start(ctx, "transaction",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.pool", t.pool),
+			attribute.String("db.isolation_level", string(txOptions.IsoLevel)),
+			attribute.String("db.access_mode", string(txOptions.AccessMode)),
+		),
+	)
+}
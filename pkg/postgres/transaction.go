@@ -6,10 +6,52 @@ import (
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Transaction struct {
 	pgx.Tx
+
+	// span - transaction span when tracing is enabled, ended by Commit or Rollback
+	span trace.Span
+}
+
+// WithSpan - ctx with span of transaction, so spans of its queries are nested in it
+func (t *Transaction) WithSpan(ctx context.Context) context.Context {
+	if t.span == nil {
+		return ctx
+	}
+
+	return trace.ContextWithSpan(ctx, t.span)
+}
+
+func (t *Transaction) Commit(ctx context.Context) error {
+	err := t.Tx.Commit(ctx)
+	endSpan(t.span, err)
+	t.span = nil
+
+	return err
+}
+
+func (t *Transaction) Rollback(ctx context.Context) error {
+	err := t.Tx.Rollback(ctx)
+	endSpan(t.span, err)
+	t.span = nil
+
+	return err
+}
+
+func endSpan(span trace.Span, err error) {
+	if span == nil {
+		return
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
 }
 
 func (t *Transaction) Getx(ctx context.Context, dest interface{}, sqlizer Sqlizer) error {
@@ -0,0 +1,199 @@
+// Package resilience - protection of calls to failing dependencies: circuit breaker and bulkhead
+package resilience
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen - call is rejected without trying, dependency is considered down
+var ErrCircuitOpen = errors.New("resilience: circuit open")
+
+// State - state of circuit breaker
+type State int
+
+const (
+	StateClosed State = iota
+	StateHalfOpen
+	StateOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half-open"
+	case StateOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	failureThresholdDefault = 5
+	openTimeoutDefault      = 10 * time.Second
+	halfOpenMaxCallsDefault = 1
+	successThresholdDefault = 1
+)
+
+// BreakerSettings - zero values are replaced with defaults
+type BreakerSettings struct {
+	// Name - label of breaker in metrics
+	Name string
+	// FailureThreshold - consecutive failures that open circuit
+	FailureThreshold int
+	// OpenTimeout - how long circuit stays open before probes are let through
+	OpenTimeout time.Duration
+	// HalfOpenMaxCalls - probes allowed at the same time in half-open state
+	HalfOpenMaxCalls int
+	// SuccessThreshold - successful probes that close circuit, any failed probe opens it again
+	SuccessThreshold int
+	// IsFailure - errors that count as failure of dependency, any error by default
+	IsFailure func(err error) bool
+}
+
+// Breaker - consecutive-failures circuit breaker with half-open probing
+type Breaker struct {
+	settings BreakerSettings
+
+	mu         sync.Mutex
+	state      State
+	generation uint64
+	failures   int
+	successes  int
+	inFlight   int
+	openedAt   time.Time
+}
+
+func NewBreaker(settings BreakerSettings) *Breaker {
+	if settings.FailureThreshold <= 0 {
+		settings.FailureThreshold = failureThresholdDefault
+	}
+	if settings.OpenTimeout <= 0 {
+		settings.OpenTimeout = openTimeoutDefault
+	}
+	if settings.HalfOpenMaxCalls <= 0 {
+		settings.HalfOpenMaxCalls = halfOpenMaxCallsDefault
+	}
+	if settings.SuccessThreshold <= 0 {
+		settings.SuccessThreshold = successThresholdDefault
+	}
+	if settings.IsFailure == nil {
+		settings.IsFailure = func(err error) bool { return err != nil }
+	}
+
+	breakerState.WithLabelValues(settings.Name).Set(float64(StateClosed))
+
+	return &Breaker{settings: settings}
+}
+
+// Execute - runs fn if circuit allows it, ErrCircuitOpen otherwise
+func (b *Breaker) Execute(ctx context.Context, fn func(ctx context.Context) error) error {
+	generation, err := b.allow()
+	if err != nil {
+		breakerCalls.WithLabelValues(b.settings.Name, "rejected").Inc()
+		return err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			b.done(generation, true)
+			panic(r)
+		}
+	}()
+
+	err = fn(ctx)
+
+	failure := b.settings.IsFailure(err)
+	if failure {
+		breakerCalls.WithLabelValues(b.settings.Name, "failure").Inc()
+	} else {
+		breakerCalls.WithLabelValues(b.settings.Name, "success").Inc()
+	}
+	b.done(generation, failure)
+
+	return err
+}
+
+// State - current state of circuit
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refresh(time.Now())
+	return b.state
+}
+
+func (b *Breaker) allow() (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refresh(time.Now())
+
+	switch b.state {
+	case StateOpen:
+		return 0, ErrCircuitOpen
+	case StateHalfOpen:
+		if b.inFlight >= b.settings.HalfOpenMaxCalls {
+			return 0, ErrCircuitOpen
+		}
+		b.inFlight++
+	}
+
+	return b.generation, nil
+}
+
+// done - result of call, calls started before last change of state are ignored
+func (b *Breaker) done(generation uint64, failure bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refresh(time.Now())
+	if generation != b.generation {
+		return
+	}
+
+	switch b.state {
+	case StateClosed:
+		if !failure {
+			b.failures = 0
+			return
+		}
+		if b.failures++; b.failures >= b.settings.FailureThreshold {
+			b.setState(StateOpen)
+		}
+	case StateHalfOpen:
+		b.inFlight--
+		if failure {
+			b.setState(StateOpen)
+			return
+		}
+		if b.successes++; b.successes >= b.settings.SuccessThreshold {
+			b.setState(StateClosed)
+		}
+	}
+}
+
+// refresh - open circuit becomes half-open after OpenTimeout
+func (b *Breaker) refresh(now time.Time) {
+	if b.state == StateOpen && now.Sub(b.openedAt) >= b.settings.OpenTimeout {
+		b.setState(StateHalfOpen)
+	}
+}
+
+func (b *Breaker) setState(state State) {
+	b.state = state
+	b.generation++
+	b.failures = 0
+	b.successes = 0
+	b.inFlight = 0
+	if state == StateOpen {
+		b.openedAt = time.Now()
+	}
+
+	breakerState.WithLabelValues(b.settings.Name).Set(float64(state))
+}
@@ -0,0 +1,156 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errBoom = errors.New("boom")
+
+func fail(context.Context) error {
+	return errBoom
+}
+
+func succeed(context.Context) error {
+	return nil
+}
+
+func TestBreakerOpens(t *testing.T) {
+	tests := []struct {
+		name      string
+		isFailure func(err error) bool
+		calls     []func(context.Context) error
+		wantState State
+	}{
+		{name: "closed below threshold", calls: []func(context.Context) error{fail, fail}, wantState: StateClosed},
+		{name: "opens at threshold", calls: []func(context.Context) error{fail, fail, fail}, wantState: StateOpen},
+		{name: "success resets failures", calls: []func(context.Context) error{fail, fail, succeed, fail, fail}, wantState: StateClosed},
+		{
+			name:      "errors that aren't failures don't count",
+			isFailure: func(err error) bool { return false },
+			calls:     []func(context.Context) error{fail, fail, fail},
+			wantState: StateClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBreaker(BreakerSettings{Name: "test", FailureThreshold: 3, OpenTimeout: time.Hour, IsFailure: tt.isFailure})
+
+			for _, call := range tt.calls {
+				_ = b.Execute(context.Background(), call)
+			}
+
+			require.Equal(t, tt.wantState, b.State())
+		})
+	}
+}
+
+func TestBreakerRejectsWhenOpen(t *testing.T) {
+	b := NewBreaker(BreakerSettings{Name: "test", FailureThreshold: 1, OpenTimeout: time.Hour})
+
+	require.ErrorIs(t, b.Execute(context.Background(), fail), errBoom)
+
+	called := false
+	err := b.Execute(context.Background(), func(context.Context) error {
+		called = true
+		return nil
+	})
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	require.False(t, called)
+}
+
+func TestBreakerHalfOpen(t *testing.T) {
+	tests := []struct {
+		name             string
+		successThreshold int
+		probes           []func(context.Context) error
+		wantState        State
+	}{
+		{name: "successful probe closes", probes: []func(context.Context) error{succeed}, wantState: StateClosed},
+		{name: "failed probe opens again", probes: []func(context.Context) error{fail}, wantState: StateOpen},
+		{name: "closes after success threshold", successThreshold: 2, probes: []func(context.Context) error{succeed, succeed}, wantState: StateClosed},
+		{name: "stays half-open below success threshold", successThreshold: 2, probes: []func(context.Context) error{succeed}, wantState: StateHalfOpen},
+		{name: "failure after successful probe opens", successThreshold: 2, probes: []func(context.Context) error{succeed, fail}, wantState: StateOpen},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBreaker(BreakerSettings{Name: "test", FailureThreshold: 1, OpenTimeout: 20 * time.Millisecond, SuccessThreshold: tt.successThreshold})
+
+			_ = b.Execute(context.Background(), fail)
+			require.Equal(t, StateOpen, b.State())
+
+			time.Sleep(30 * time.Millisecond)
+			require.Equal(t, StateHalfOpen, b.State())
+
+			for _, probe := range tt.probes {
+				_ = b.Execute(context.Background(), probe)
+			}
+
+			require.Equal(t, tt.wantState, b.State())
+		})
+	}
+}
+
+func TestBreakerHalfOpenMaxCalls(t *testing.T) {
+	b := NewBreaker(BreakerSettings{Name: "test", FailureThreshold: 1, OpenTimeout: 10 * time.Millisecond})
+
+	_ = b.Execute(context.Background(), fail)
+	time.Sleep(20 * time.Millisecond)
+
+	started, release := make(chan struct{}), make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- b.Execute(context.Background(), func(context.Context) error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	// only one probe at a time
+	require.ErrorIs(t, b.Execute(context.Background(), succeed), ErrCircuitOpen)
+
+	close(release)
+	require.NoError(t, <-done)
+	require.Equal(t, StateClosed, b.State())
+}
+
+func TestBreakerIgnoresCallsOfPreviousState(t *testing.T) {
+	b := NewBreaker(BreakerSettings{Name: "test", FailureThreshold: 1, OpenTimeout: time.Hour})
+
+	started, release := make(chan struct{}), make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- b.Execute(context.Background(), func(context.Context) error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	// circuit opens while the first call is in flight, its late success doesn't close it
+	_ = b.Execute(context.Background(), fail)
+	close(release)
+	require.NoError(t, <-done)
+
+	require.Equal(t, StateOpen, b.State())
+}
+
+func TestBreakerPanicIsFailure(t *testing.T) {
+	b := NewBreaker(BreakerSettings{Name: "test", FailureThreshold: 1, OpenTimeout: time.Hour})
+
+	require.Panics(t, func() {
+		_ = b.Execute(context.Background(), func(context.Context) error {
+			panic("boom")
+		})
+	})
+	require.Equal(t, StateOpen, b.State())
+}
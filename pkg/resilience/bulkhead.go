@@ -0,0 +1,74 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrBulkheadFull - call is rejected as dependency already has max concurrent calls
+var ErrBulkheadFull = errors.New("resilience: bulkhead full")
+
+// Bulkhead - limits concurrent calls to dependency, so slow dependency can't take all goroutines and connections
+type Bulkhead struct {
+	name    string
+	slots   chan struct{}
+	maxWait time.Duration
+}
+
+// NewBulkhead - at most maxConcurrent calls at once, call waits for free slot up to maxWait (0 - doesn't wait)
+func NewBulkhead(name string, maxConcurrent int, maxWait time.Duration) *Bulkhead {
+	if maxConcurrent <= 0 {
+		maxConcurrent = 1
+	}
+
+	bulkheadInFlight.WithLabelValues(name).Set(0)
+
+	return &Bulkhead{
+		name:    name,
+		slots:   make(chan struct{}, maxConcurrent),
+		maxWait: maxWait,
+	}
+}
+
+// Execute - runs fn in free slot, ErrBulkheadFull if none became free in time
+func (b *Bulkhead) Execute(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := b.acquire(ctx); err != nil {
+		bulkheadRejected.WithLabelValues(b.name).Inc()
+		return err
+	}
+	defer b.release()
+
+	return fn(ctx)
+}
+
+func (b *Bulkhead) acquire(ctx context.Context) error {
+	select {
+	case b.slots <- struct{}{}:
+		bulkheadInFlight.WithLabelValues(b.name).Inc()
+		return nil
+	default:
+	}
+
+	if b.maxWait <= 0 {
+		return ErrBulkheadFull
+	}
+
+	timer := time.NewTimer(b.maxWait)
+	defer timer.Stop()
+
+	select {
+	case b.slots <- struct{}{}:
+		bulkheadInFlight.WithLabelValues(b.name).Inc()
+		return nil
+	case <-timer.C:
+		return ErrBulkheadFull
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *Bulkhead) release() {
+	<-b.slots
+	bulkheadInFlight.WithLabelValues(b.name).Dec()
+}
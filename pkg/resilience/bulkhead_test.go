@@ -0,0 +1,86 @@
+package resilience
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// occupy - takes a slot of b until returned func is called
+func occupy(b *Bulkhead) func() {
+	started, release := make(chan struct{}), make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = b.Execute(context.Background(), func(context.Context) error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	return func() {
+		close(release)
+		<-done
+	}
+}
+
+func TestBulkhead(t *testing.T) {
+	tests := []struct {
+		name                string
+		maxWait             time.Duration
+		ctxTimeout          time.Duration
+		releaseWhileWaiting bool
+		wantErr             error
+	}{
+		{name: "rejects at once without wait", wantErr: ErrBulkheadFull},
+		{name: "rejects after wait", maxWait: 10 * time.Millisecond, wantErr: ErrBulkheadFull},
+		{name: "gets slot released while waiting", maxWait: time.Second, releaseWhileWaiting: true},
+		{name: "stops waiting when ctx is done", maxWait: time.Second, ctxTimeout: 10 * time.Millisecond, wantErr: context.DeadlineExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBulkhead("test", 1, tt.maxWait)
+
+			release := occupy(b)
+			if tt.releaseWhileWaiting {
+				go func() {
+					time.Sleep(10 * time.Millisecond)
+					release()
+				}()
+			} else {
+				defer release()
+			}
+
+			ctx := context.Background()
+			if tt.ctxTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.ctxTimeout)
+				defer cancel()
+			}
+
+			err := b.Execute(ctx, succeed)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBulkheadConcurrency(t *testing.T) {
+	b := NewBulkhead("test", 2, 0)
+	releaseFirst := occupy(b)
+	releaseSecond := occupy(b)
+	defer releaseSecond()
+
+	require.ErrorIs(t, b.Execute(context.Background(), succeed), ErrBulkheadFull)
+
+	releaseFirst()
+	require.NoError(t, b.Execute(context.Background(), succeed))
+}
@@ -0,0 +1,44 @@
+package resilience
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	breakerState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "circuit_breaker_state",
+			Help: "State of circuit breaker: 0 - closed, 1 - half-open, 2 - open.",
+		},
+		[]string{"name"},
+	)
+
+	breakerCalls = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "circuit_breaker_calls_total",
+			Help: "Calls through circuit breaker by result: success, failure or rejected.",
+		},
+		[]string{"name", "result"},
+	)
+
+	bulkheadInFlight = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "bulkhead_in_flight",
+			Help: "Calls currently running in bulkhead.",
+		},
+		[]string{"name"},
+	)
+
+	bulkheadRejected = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "bulkhead_rejected_total",
+			Help: "Calls rejected as bulkhead was full.",
+		},
+		[]string{"name"},
+	)
+)
+
+// RegisterMetrics - registers breaker and bulkhead metrics in default prometheus registry
+func RegisterMetrics() {
+	prometheus.MustRegister(breakerState, breakerCalls, bulkheadInFlight, bulkheadRejected)
+}
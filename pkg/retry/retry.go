@@ -0,0 +1,141 @@
+// Package retry - retries of operations with transient failures according to Policy
+package retry
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// Policy - how many times and how often operation is retried, zero values disable corresponding limit
+type Policy struct {
+	// MaxAttempts - attempts including the first one
+	MaxAttempts int
+	// MaxElapsedTime - no attempt is started after this time since the first one
+	MaxElapsedTime time.Duration
+	// AttemptTimeout - deadline of ctx of single attempt
+	AttemptTimeout time.Duration
+
+	// delay before n-th retry is InitialDelay * Multiplier^(n-1), capped by MaxDelay
+	InitialDelay time.Duration
+	MaxDelay     time.Duration
+	Multiplier   float64
+	// Jitter - part of delay that is random, 1 means delay is anywhere in [0, delay]
+	Jitter float64
+
+	// Retryable - classification of errors, IsRetryable by default
+	Retryable func(err error) bool
+
+	// OnRetry - called before sleeping between attempts, e.g. for logging or metrics
+	OnRetry func(attempt int, err error, delay time.Duration)
+	// OnGiveUp - called when retryable error is returned because limits are exhausted
+	OnGiveUp func(attempts int, err error)
+}
+
+// DefaultPolicy - 3 attempts with exponential backoff from 100ms, 5s per attempt
+func DefaultPolicy() Policy {
+	return Policy{
+		MaxAttempts:    3,
+		AttemptTimeout: 5 * time.Second,
+		InitialDelay:   100 * time.Millisecond,
+		MaxDelay:       2 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.5,
+	}
+}
+
+// Do - runs fn until it succeeds, returns non retryable error or policy limits are exhausted.
+// Permanent errors are returned unwrapped. Sleeping between attempts is interrupted by ctx
+func Do(ctx context.Context, policy Policy, fn func(ctx context.Context) error) error {
+	if fn == nil {
+		return errors.New("retry: nil operation")
+	}
+
+	retryable := policy.Retryable
+	if retryable == nil {
+		retryable = IsRetryable
+	}
+
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		err := runAttempt(ctx, policy.AttemptTimeout, fn)
+		if err == nil {
+			return nil
+		}
+
+		var permanent *permanentError
+		if errors.As(err, &permanent) {
+			return permanent.err
+		}
+		if !retryable(err) {
+			return err
+		}
+
+		delay := policy.delay(attempt)
+		exhausted := (policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts) ||
+			(policy.MaxElapsedTime > 0 && time.Since(start)+delay > policy.MaxElapsedTime)
+		if exhausted || ctx.Err() != nil {
+			if policy.OnGiveUp != nil {
+				policy.OnGiveUp(attempt, err)
+			}
+			return err
+		}
+
+		if policy.OnRetry != nil {
+			policy.OnRetry(attempt, err, delay)
+		}
+
+		if !sleep(ctx, delay) {
+			return err
+		}
+	}
+}
+
+func runAttempt(ctx context.Context, timeout time.Duration, fn func(ctx context.Context) error) error {
+	if timeout <= 0 {
+		return fn(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return fn(ctx)
+}
+
+// delay - exponential delay before retry after attempt, with jitter
+func (p Policy) delay(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	delay := float64(p.InitialDelay) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+
+	if jitter := math.Min(math.Max(p.Jitter, 0), 1); jitter > 0 {
+		delay -= delay * jitter * rand.Float64()
+	}
+
+	return time.Duration(delay)
+}
+
+// sleep - false if ctx is done before d passed
+func sleep(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
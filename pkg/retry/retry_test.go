@@ -0,0 +1,217 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	errTransient = &pgconn.PgError{Code: pgerrcode.SerializationFailure}
+)
+
+func TestPolicyDelay(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  Policy
+		attempt int
+		want    time.Duration
+	}{
+		{name: "first retry", policy: Policy{InitialDelay: 100 * time.Millisecond, Multiplier: 2}, attempt: 1, want: 100 * time.Millisecond},
+		{name: "exponential", policy: Policy{InitialDelay: 100 * time.Millisecond, Multiplier: 2}, attempt: 4, want: 800 * time.Millisecond},
+		{name: "capped by max delay", policy: Policy{InitialDelay: 100 * time.Millisecond, MaxDelay: time.Second, Multiplier: 2}, attempt: 10, want: time.Second},
+		{name: "multiplier below 1 is constant delay", policy: Policy{InitialDelay: 100 * time.Millisecond, Multiplier: 0.5}, attempt: 5, want: 100 * time.Millisecond},
+		{name: "no delay", policy: Policy{}, attempt: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.policy.delay(tt.attempt))
+		})
+	}
+}
+
+func TestPolicyDelayJitter(t *testing.T) {
+	tests := []struct {
+		name     string
+		jitter   float64
+		min, max time.Duration
+	}{
+		{name: "half of delay is random", jitter: 0.5, min: 500 * time.Millisecond, max: time.Second},
+		{name: "full jitter", jitter: 1, min: 0, max: time.Second},
+		{name: "jitter above 1 is full jitter", jitter: 3, min: 0, max: time.Second},
+		{name: "negative jitter is none", jitter: -1, min: time.Second, max: time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := Policy{InitialDelay: time.Second, Multiplier: 1, Jitter: tt.jitter}
+
+			for i := 0; i < 1000; i++ {
+				delay := policy.delay(1)
+				require.GreaterOrEqual(t, delay, tt.min)
+				require.LessOrEqual(t, delay, tt.max)
+			}
+		})
+	}
+}
+
+func TestDo(t *testing.T) {
+	tests := []struct {
+		name      string
+		failures  int
+		err       error
+		retryable func(err error) bool
+		wantCalls int
+		wantErr   error
+	}{
+		{name: "success", wantCalls: 1},
+		{name: "transient error is retried", failures: 2, err: errTransient, wantCalls: 3},
+		{name: "attempts are exhausted", failures: 10, err: errTransient, wantCalls: 3, wantErr: errTransient},
+		{name: "other error is not retried", failures: 10, err: errBoom, wantCalls: 1, wantErr: errBoom},
+		{name: "permanent error is not retried", failures: 10, err: Permanent(errTransient), wantCalls: 1, wantErr: errTransient},
+		{name: "custom classification", failures: 2, err: errBoom, retryable: func(err error) bool { return errors.Is(err, errBoom) }, wantCalls: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := Policy{MaxAttempts: 3, Retryable: tt.retryable}
+
+			calls := 0
+			err := Do(context.Background(), policy, func(ctx context.Context) error {
+				calls++
+				if calls <= tt.failures {
+					return tt.err
+				}
+				return nil
+			})
+
+			require.Equal(t, tt.wantCalls, calls)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.False(t, IsPermanent(err), "permanent error is returned unwrapped")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDoMaxElapsedTime(t *testing.T) {
+	policy := Policy{MaxElapsedTime: 50 * time.Millisecond, InitialDelay: 20 * time.Millisecond, Multiplier: 1}
+
+	var gaveUp int
+	policy.OnGiveUp = func(attempts int, err error) {
+		gaveUp = attempts
+	}
+
+	calls := 0
+	err := Do(context.Background(), policy, func(ctx context.Context) error {
+		calls++
+		return errTransient
+	})
+
+	require.ErrorIs(t, err, errTransient)
+	// attempts at 0, 20 and 40ms, the one that would start after MaxElapsedTime isn't started
+	// (slow scheduler may skip the last one)
+	require.GreaterOrEqual(t, calls, 2)
+	require.LessOrEqual(t, calls, 3)
+	require.Equal(t, calls, gaveUp)
+}
+
+func TestDoStopsOnCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	err := Do(ctx, Policy{MaxAttempts: 5, InitialDelay: time.Hour}, func(ctx context.Context) error {
+		calls++
+		cancel()
+		return errTransient
+	})
+
+	require.ErrorIs(t, err, errTransient)
+	require.Equal(t, 1, calls)
+}
+
+func TestDoAttemptTimeout(t *testing.T) {
+	calls := 0
+	err := Do(context.Background(), Policy{MaxAttempts: 2, AttemptTimeout: 10 * time.Millisecond}, func(ctx context.Context) error {
+		calls++
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	// deadline of attempt is retried
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, 2, calls)
+}
+
+func TestDoOnRetry(t *testing.T) {
+	policy := Policy{MaxAttempts: 3, InitialDelay: time.Millisecond, Multiplier: 2}
+
+	var attempts []int
+	var delays []time.Duration
+	policy.OnRetry = func(attempt int, err error, delay time.Duration) {
+		require.ErrorIs(t, err, errTransient)
+		attempts = append(attempts, attempt)
+		delays = append(delays, delay)
+	}
+
+	err := Do(context.Background(), policy, func(ctx context.Context) error {
+		return errTransient
+	})
+
+	require.ErrorIs(t, err, errTransient)
+	require.Equal(t, []int{1, 2}, attempts)
+	require.Equal(t, []time.Duration{time.Millisecond, 2 * time.Millisecond}, delays)
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil},
+		{name: "other error", err: errBoom},
+		{name: "serialization failure", err: errTransient, want: true},
+		{name: "deadlock", err: &pgconn.PgError{Code: pgerrcode.DeadlockDetected}, want: true},
+		{name: "connection failure", err: &pgconn.PgError{Code: pgerrcode.ConnectionFailure}, want: true},
+		{name: "unique violation", err: &pgconn.PgError{Code: pgerrcode.UniqueViolation}},
+		{name: "wrapped transient error", err: fmt.Errorf("storage: %w", errTransient), want: true},
+		{name: "permanent transient error", err: Permanent(errTransient)},
+		{name: "cancelled by caller", err: context.Canceled},
+		{name: "deadline", err: context.DeadlineExceeded, want: true},
+		{name: "network error", err: &net.OpError{Op: "dial", Err: errBoom}, want: true},
+		{name: "connection reset", err: fmt.Errorf("read: %w", syscall.ECONNRESET), want: true},
+		{name: "connection refused", err: syscall.ECONNREFUSED, want: true},
+		{name: "unexpected EOF", err: io.ErrUnexpectedEOF, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, IsRetryable(tt.err))
+		})
+	}
+}
+
+func TestPermanent(t *testing.T) {
+	require.NoError(t, Permanent(nil))
+
+	err := Permanent(errBoom)
+	require.True(t, IsPermanent(err))
+	require.True(t, IsPermanent(fmt.Errorf("wrapped: %w", err)))
+	require.ErrorIs(t, err, errBoom)
+	require.Equal(t, errBoom.Error(), err.Error())
+	require.False(t, IsPermanent(errBoom))
+}
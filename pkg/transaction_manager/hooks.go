@@ -0,0 +1,55 @@
+package transaction_manager
+
+import (
+	"context"
+)
+
+// hooks - callbacks registered in scope of transaction (or its savepoint)
+type hooks struct {
+	afterCommit []func(ctx context.Context)
+	onRollback  []func(ctx context.Context)
+}
+
+// AfterCommit - registers fn to be called after transaction of ctx is committed, fn is dropped on rollback.
+// Hook registered inside savepoint runs after commit of outermost transaction.
+// Without transaction fn is called immediately, as there is nothing to wait for
+func AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	h, ok := ctx.Value(hooksKey).(*hooks)
+	if !ok {
+		fn(ctx)
+		return
+	}
+
+	h.afterCommit = append(h.afterCommit, fn)
+}
+
+// OnRollback - registers fn to be called after transaction (or savepoint) of ctx is rolled back.
+// Without transaction fn is never called
+func OnRollback(ctx context.Context, fn func(ctx context.Context)) {
+	h, ok := ctx.Value(hooksKey).(*hooks)
+	if !ok {
+		return
+	}
+
+	h.onRollback = append(h.onRollback, fn)
+}
+
+// committed - released savepoint passes its hooks to outer transaction,
+// committed outermost transaction runs after-commit hooks
+func (h *hooks) committed(ctx context.Context, parent *hooks) {
+	if parent != nil {
+		parent.afterCommit = append(parent.afterCommit, h.afterCommit...)
+		parent.onRollback = append(parent.onRollback, h.onRollback...)
+		return
+	}
+
+	for _, fn := range h.afterCommit {
+		fn(ctx)
+	}
+}
+
+func (h *hooks) rolledBack(ctx context.Context) {
+	for _, fn := range h.onRollback {
+		fn(ctx)
+	}
+}
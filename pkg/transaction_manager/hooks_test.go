@@ -0,0 +1,118 @@
+package transaction_manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// calls - names of hooks in order they were called
+type calls []string
+
+func (c *calls) hook(name string) func(ctx context.Context) {
+	return func(context.Context) {
+		*c = append(*c, name)
+	}
+}
+
+func TestAfterCommitRunsAfterCommit(t *testing.T) {
+	var got calls
+
+	statements, err := inTx(readCommitted, func(ctx context.Context) error {
+		AfterCommit(ctx, got.hook("after commit"))
+		OnRollback(ctx, got.hook("on rollback"))
+
+		require.Empty(t, got)
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"COMMIT"}, statements)
+	require.Equal(t, calls{"after commit"}, got)
+}
+
+func TestOnRollbackRunsAfterRollback(t *testing.T) {
+	var got calls
+
+	_, err := inTx(readCommitted, func(ctx context.Context) error {
+		AfterCommit(ctx, got.hook("after commit"))
+		OnRollback(ctx, got.hook("on rollback"))
+		return errBoom
+	})
+
+	require.ErrorIs(t, err, errBoom)
+	require.Equal(t, calls{"on rollback"}, got)
+}
+
+func TestReleasedSavepointPassesHooksToOuter(t *testing.T) {
+	m := New(nil)
+	var got calls
+
+	_, err := inTx(readCommitted, func(ctx context.Context) error {
+		err := m.RunReadCommitted(ctx, pgx.ReadWrite, func(ctx context.Context) error {
+			AfterCommit(ctx, got.hook("nested after commit"))
+			return nil
+		})
+		require.NoError(t, err)
+
+		// savepoint is released, but transaction may still be rolled back
+		require.Empty(t, got)
+
+		AfterCommit(ctx, got.hook("outer after commit"))
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, calls{"nested after commit", "outer after commit"}, got)
+}
+
+func TestReleasedSavepointHooksRollBackWithOuter(t *testing.T) {
+	m := New(nil)
+	var got calls
+
+	_, err := inTx(readCommitted, func(ctx context.Context) error {
+		err := m.RunReadCommitted(ctx, pgx.ReadWrite, func(ctx context.Context) error {
+			AfterCommit(ctx, got.hook("nested after commit"))
+			OnRollback(ctx, got.hook("nested on rollback"))
+			return nil
+		})
+		require.NoError(t, err)
+
+		return errBoom
+	})
+
+	require.ErrorIs(t, err, errBoom)
+	require.Equal(t, calls{"nested on rollback"}, got)
+}
+
+func TestRolledBackSavepointDropsHooks(t *testing.T) {
+	m := New(nil)
+	var got calls
+
+	_, err := inTx(readCommitted, func(ctx context.Context) error {
+		err := m.RunReadCommitted(ctx, pgx.ReadWrite, func(ctx context.Context) error {
+			AfterCommit(ctx, got.hook("nested after commit"))
+			OnRollback(ctx, got.hook("nested on rollback"))
+			return errBoom
+		})
+		require.ErrorIs(t, err, errBoom)
+
+		// rollback to savepoint is final, its hooks run at once
+		require.Equal(t, calls{"nested on rollback"}, got)
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, calls{"nested on rollback"}, got)
+}
+
+func TestHooksWithoutTransaction(t *testing.T) {
+	var got calls
+
+	AfterCommit(context.Background(), got.hook("after commit"))
+	OnRollback(context.Background(), got.hook("on rollback"))
+
+	require.Equal(t, calls{"after commit"}, got)
+}
@@ -0,0 +1,30 @@
+package transaction_manager
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// TransactionRetries - transactions retried after serialization failure or deadlock
+	TransactionRetries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "db_transaction_retries_total",
+			Help: "Transactions retried after serialization failure or deadlock.",
+		},
+		[]string{"reason"},
+	)
+
+	// TransactionRetriesExhausted - transactions failed after max attempts
+	TransactionRetriesExhausted = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "db_transaction_retries_exhausted_total",
+			Help: "Transactions failed with serialization failure or deadlock after max attempts.",
+		},
+		[]string{"reason"},
+	)
+)
+
+// RegisterMetrics - registers transaction metrics in default prometheus registry
+func RegisterMetrics() {
+	prometheus.MustRegister(TransactionRetries, TransactionRetriesExhausted)
+}
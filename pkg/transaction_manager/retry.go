@@ -0,0 +1,96 @@
+package transaction_manager
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	maxAttemptsDefault    = 5
+	retryBaseDelayDefault = 10 * time.Millisecond
+	retryMaxDelayDefault  = 500 * time.Millisecond
+)
+
+type options struct {
+	maxAttempts    int
+	retryBaseDelay time.Duration
+	retryMaxDelay  time.Duration
+}
+
+type Option func(opts *options)
+
+// WithMaxAttempts - how many times RunSerializable runs transaction function, 1 disables retries
+func WithMaxAttempts(n int) Option {
+	return func(opts *options) {
+		opts.maxAttempts = n
+	}
+}
+
+// WithRetryBackoff - delay before retry grows exponentially from base up to max, actual delay is random in [0, delay)
+func WithRetryBackoff(base, max time.Duration) Option {
+	return func(opts *options) {
+		opts.retryBaseDelay = base
+		opts.retryMaxDelay = max
+	}
+}
+
+// retryReason - label of retryable error, empty for errors that must not be retried
+func retryReason(err error) string {
+	var pgError *pgconn.PgError
+	if !errors.As(err, &pgError) {
+		return ""
+	}
+
+	switch pgError.Code {
+	case pgerrcode.SerializationFailure:
+		return "serialization_failure"
+	case pgerrcode.DeadlockDetected:
+		return "deadlock"
+	default:
+		return ""
+	}
+}
+
+// withRetries - runs whole transaction again while it fails with serialization failure or deadlock
+func (m *TransactionManager) withRetries(ctx context.Context, run func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := run()
+
+		reason := retryReason(err)
+		if reason == "" {
+			return err
+		}
+		if attempt >= m.opts.maxAttempts {
+			TransactionRetriesExhausted.WithLabelValues(reason).Inc()
+			return err
+		}
+
+		TransactionRetries.WithLabelValues(reason).Inc()
+
+		timer := time.NewTimer(m.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff - full jitter: random delay up to base * 2^(attempt-1), capped by max
+func (m *TransactionManager) backoff(attempt int) time.Duration {
+	delay := m.opts.retryMaxDelay
+	if shift := attempt - 1; shift < 32 && m.opts.retryBaseDelay<<shift < delay {
+		delay = m.opts.retryBaseDelay << shift
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	return rand.N(delay)
+}
@@ -0,0 +1,107 @@
+package transaction_manager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	serializationFailure = &pgconn.PgError{Code: pgerrcode.SerializationFailure}
+	uniqueViolation      = &pgconn.PgError{Code: pgerrcode.UniqueViolation}
+)
+
+func TestWithRetries(t *testing.T) {
+	tests := []struct {
+		name      string
+		failures  int
+		err       error
+		wantCalls int
+		wantErr   error
+	}{
+		{name: "success", wantCalls: 1},
+		{name: "serialization failure is retried", failures: 2, err: serializationFailure, wantCalls: 3},
+		{name: "deadlock is retried", failures: 1, err: &pgconn.PgError{Code: pgerrcode.DeadlockDetected}, wantCalls: 2},
+		{name: "attempts are exhausted", failures: 10, err: serializationFailure, wantCalls: 3, wantErr: serializationFailure},
+		{name: "other error is not retried", failures: 10, err: errBoom, wantCalls: 1, wantErr: errBoom},
+		{name: "other postgres error is not retried", failures: 10, err: uniqueViolation, wantCalls: 1, wantErr: uniqueViolation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New(nil, WithMaxAttempts(3), WithRetryBackoff(0, 0))
+
+			calls := 0
+			err := m.withRetries(context.Background(), func() error {
+				calls++
+				if calls <= tt.failures {
+					return tt.err
+				}
+				return nil
+			})
+
+			require.Equal(t, tt.wantCalls, calls)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWithRetriesStopsOnCancelledContext(t *testing.T) {
+	m := New(nil, WithMaxAttempts(3), WithRetryBackoff(time.Hour, time.Hour))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	err := m.withRetries(ctx, func() error {
+		calls++
+		return serializationFailure
+	})
+
+	require.ErrorIs(t, err, serializationFailure)
+	require.Equal(t, 1, calls)
+}
+
+func TestNestedSerializableIsNotRetried(t *testing.T) {
+	m := New(nil, WithMaxAttempts(3), WithRetryBackoff(0, 0))
+
+	calls := 0
+	statements, err := inTx(pgx.TxOptions{IsoLevel: pgx.Serializable, AccessMode: pgx.ReadWrite}, func(ctx context.Context) error {
+		return m.RunSerializable(ctx, pgx.ReadWrite, func(ctx context.Context) error {
+			calls++
+			return serializationFailure
+		})
+	})
+
+	// failed transaction can only be retried from its beginning, so it's up to outermost RunSerializable
+	require.ErrorIs(t, err, serializationFailure)
+	require.Equal(t, 1, calls)
+	require.Equal(t, []string{"SAVEPOINT sp1", "ROLLBACK TO SAVEPOINT sp1", "ROLLBACK"}, statements)
+}
+
+func TestBackoff(t *testing.T) {
+	m := New(nil, WithRetryBackoff(10*time.Millisecond, 50*time.Millisecond))
+
+	for attempt, limit := range map[int]time.Duration{
+		1:  10 * time.Millisecond,
+		2:  20 * time.Millisecond,
+		3:  40 * time.Millisecond,
+		4:  50 * time.Millisecond,
+		40: 50 * time.Millisecond,
+	} {
+		for range 100 {
+			delay := m.backoff(attempt)
+			require.GreaterOrEqual(t, delay, time.Duration(0))
+			require.Less(t, delay, limit, "attempt %d", attempt)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package transaction_manager
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/R1ckNash/Bank/pkg/postgres"
 	"github.com/jackc/pgx/v5"
@@ -10,24 +11,51 @@ import (
 // TransactionManager - allows to execute functions of different repositories using same db in scope of one transaction
 type TransactionManager struct {
 	connection *postgres.Connection
+	opts       options
 }
 
 // New constructs TransactionManager
-func New(connection *postgres.Connection) *TransactionManager {
-	return &TransactionManager{connection: connection}
+func New(connection *postgres.Connection, opts ...Option) *TransactionManager {
+	options := options{
+		maxAttempts:    maxAttemptsDefault,
+		retryBaseDelay: retryBaseDelayDefault,
+		retryMaxDelay:  retryMaxDelayDefault,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return &TransactionManager{connection: connection, opts: options}
 }
 
 type key string
 
 const (
-	txKey key = "tx"
+	txKey     key = "tx"
+	txOptsKey key = "txOpts"
+	hooksKey  key = "txHooks"
 )
 
-func (m *TransactionManager) runTransaction(ctx context.Context, txOpts pgx.TxOptions, fn func(ctx context.Context) error) (err error) {
-	// If it's nested Transaction, skip initiating a new one and return func(ctx context.Context) error
-	tx, ok := ctx.Value(txKey).(*postgres.Transaction)
-	if ok {
-		return fn(ctx)
+var (
+	// ErrStricterIsolation - nested call requires isolation level stricter than one of outer transaction
+	ErrStricterIsolation = errors.New("nested transaction requires stricter isolation level")
+	// ErrReadWriteInReadOnly - nested call requires ReadWrite access inside ReadOnly transaction
+	ErrReadWriteInReadOnly = errors.New("nested read write transaction inside read only transaction")
+)
+
+// isolationRank - isolation levels from the weakest, default level of postgres is read committed
+var isolationRank = map[pgx.TxIsoLevel]int{
+	pgx.ReadUncommitted: 0,
+	"":                  1,
+	pgx.ReadCommitted:   1,
+	pgx.RepeatableRead:  2,
+	pgx.Serializable:    3,
+}
+
+func (m *TransactionManager) runTransaction(ctx context.Context, txOpts pgx.TxOptions, fn func(ctx context.Context) error) error {
+	// If it's nested Transaction, run func(ctx context.Context) error in savepoint of outer one
+	if tx, ok := ctx.Value(txKey).(*postgres.Transaction); ok {
+		return m.runNested(ctx, tx, txOpts, fn)
 	}
 
 	// Begin runTransaction
@@ -36,9 +64,43 @@ func (m *TransactionManager) runTransaction(ctx context.Context, txOpts pgx.TxOp
 		return fmt.Errorf("can't begin transaction: %v", err)
 	}
 
-	tx = &postgres.Transaction{Tx: pgxTx}
-	// Set txKey to context
+	return run(ctx, &postgres.Transaction{Tx: pgxTx}, txOpts, fn)
+}
+
+// runNested - error of nested func(ctx context.Context) error rolls back to savepoint only,
+// so outer transaction stays usable when caller tolerates it
+func (m *TransactionManager) runNested(ctx context.Context, outer *postgres.Transaction, txOpts pgx.TxOptions, fn func(ctx context.Context) error) error {
+	outerOpts, _ := ctx.Value(txOptsKey).(pgx.TxOptions)
+
+	// isolation and access mode can't be changed inside transaction
+	if isolationRank[txOpts.IsoLevel] > isolationRank[outerOpts.IsoLevel] {
+		return fmt.Errorf("%w: %s inside %s", ErrStricterIsolation, txOpts.IsoLevel, outerOpts.IsoLevel)
+	}
+	if outerOpts.AccessMode == pgx.ReadOnly && txOpts.AccessMode != pgx.ReadOnly {
+		return ErrReadWriteInReadOnly
+	}
+
+	// pgx begins nested transaction as SAVEPOINT, its Commit and Rollback are RELEASE and ROLLBACK TO SAVEPOINT
+	savepoint, err := outer.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("can't create savepoint: %v", err)
+	}
+
+	return run(ctx, &postgres.Transaction{Tx: savepoint}, outerOpts, fn)
+}
+
+// run - executes func(ctx context.Context) error in tx, commits it on success and rolls back otherwise
+func run(ctx context.Context, tx *postgres.Transaction, txOpts pgx.TxOptions, fn func(ctx context.Context) error) (err error) {
+	// hooks are called with ctx of caller, tx is already finished by then
+	callerCtx := ctx
+	parentHooks, _ := ctx.Value(hooksKey).(*hooks)
+	txHooks := &hooks{}
+
+	// Set txKey to context, options are the ones of outermost transaction
 	ctx = context.WithValue(ctx, txKey, tx)
+	ctx = context.WithValue(ctx, txOptsKey, txOpts)
+	ctx = context.WithValue(ctx, hooksKey, txHooks)
+	ctx = tx.WithSpan(ctx)
 
 	// Set up a defer function for rolling back the runTransaction.
 	defer func() {
@@ -52,16 +114,20 @@ func (m *TransactionManager) runTransaction(ctx context.Context, txOpts pgx.TxOp
 			// if commit returns error -> rollback
 			err = tx.Commit(ctx)
 			if err != nil {
-				err = fmt.Errorf("commit failed: %v", err)
+				err = fmt.Errorf("commit failed: %w", err)
 			}
 		}
 
-		// rollback on any error
+		// rollback on any error, failed commit has already closed tx
 		if err != nil {
-			if errRollback := tx.Rollback(ctx); errRollback != nil {
-				err = fmt.Errorf("rollback failed: %v", errRollback)
+			if errRollback := tx.Rollback(ctx); errRollback != nil && !errors.Is(errRollback, pgx.ErrTxClosed) {
+				err = fmt.Errorf("rollback failed: %v: %w", errRollback, err)
 			}
+			txHooks.rolledBack(callerCtx)
+			return
 		}
+
+		txHooks.committed(callerCtx, parentHooks)
 	}()
 
 	// Execute the code inside the runTransaction. If the function
@@ -75,7 +141,8 @@ func (m *TransactionManager) runTransaction(ctx context.Context, txOpts pgx.TxOp
 
 // GetQueryEngine provides QueryEngine
 func (m *TransactionManager) GetQueryEngine(ctx context.Context) QueryEngine {
-	// Transaction always runs on node with NodeRoleWrite role
+	// Transaction runs on node it was started on: primary, or replica for read only one.
+	// Outside of transaction connection sends read queries to replicas
 	if tx, ok := ctx.Value(txKey).(QueryEngine); ok {
 		return tx
 	}
@@ -83,6 +150,12 @@ func (m *TransactionManager) GetQueryEngine(ctx context.Context) QueryEngine {
 	return m.connection
 }
 
+// WithPrimary - reads with returned ctx (and read only transactions) go to primary,
+// use it to read data right after writing it
+func WithPrimary(ctx context.Context) context.Context {
+	return postgres.WithPrimary(ctx)
+}
+
 // RunReadCommitted execs f func in runTransaction with LevelReadCommitted isolation level
 func (m *TransactionManager) RunReadCommitted(ctx context.Context, accessMode pgx.TxAccessMode, f func(ctx context.Context) error) error {
 	return m.runTransaction(ctx, pgx.TxOptions{
@@ -99,10 +172,20 @@ func (m *TransactionManager) RunRepeatableRead(ctx context.Context, accessMode p
 	}, f)
 }
 
-// RunSerializable execs f func in runTransaction with LevelSerializable isolation level
+// RunSerializable execs f func in runTransaction with LevelSerializable isolation level.
+// Transaction failed with serialization failure or deadlock is retried from scratch (see WithMaxAttempts),
+// so f must not have side effects outside of transaction. Nested call is not retried - outer transaction is
 func (m *TransactionManager) RunSerializable(ctx context.Context, accessMode pgx.TxAccessMode, f func(ctx context.Context) error) error {
-	return m.runTransaction(ctx, pgx.TxOptions{
+	txOpts := pgx.TxOptions{
 		IsoLevel:   pgx.Serializable,
 		AccessMode: accessMode,
-	}, f)
+	}
+
+	if _, ok := ctx.Value(txKey).(*postgres.Transaction); ok {
+		return m.runTransaction(ctx, txOpts, f)
+	}
+
+	return m.withRetries(ctx, func() error {
+		return m.runTransaction(ctx, txOpts, f)
+	})
 }
@@ -0,0 +1,226 @@
+package transaction_manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/R1ckNash/Bank/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+var errBoom = errors.New("boom")
+
+// fakeDB - statements sent by transaction and its savepoints
+type fakeDB struct {
+	statements []string
+	savepoints int
+}
+
+// fakeTx - records statements pgx would send instead of sending them, nested Begin is a savepoint
+type fakeTx struct {
+	pgx.Tx
+
+	db        *fakeDB
+	savepoint string
+	closed    bool
+}
+
+func (t *fakeTx) Begin(context.Context) (pgx.Tx, error) {
+	t.db.savepoints++
+	sp := &fakeTx{db: t.db, savepoint: fmt.Sprintf("sp%d", t.db.savepoints)}
+	t.record("SAVEPOINT " + sp.savepoint)
+
+	return sp, nil
+}
+
+func (t *fakeTx) Commit(context.Context) error {
+	if t.closed {
+		return pgx.ErrTxClosed
+	}
+	t.closed = true
+
+	if t.savepoint == "" {
+		t.record("COMMIT")
+	} else {
+		t.record("RELEASE SAVEPOINT " + t.savepoint)
+	}
+	return nil
+}
+
+func (t *fakeTx) Rollback(context.Context) error {
+	if t.closed {
+		return pgx.ErrTxClosed
+	}
+	t.closed = true
+
+	if t.savepoint == "" {
+		t.record("ROLLBACK")
+	} else {
+		t.record("ROLLBACK TO SAVEPOINT " + t.savepoint)
+	}
+	return nil
+}
+
+func (t *fakeTx) record(statement string) {
+	t.db.statements = append(t.db.statements, statement)
+}
+
+// inTx - runs fn in outermost transaction with txOpts, returns statements sent by it and its nested calls
+func inTx(txOpts pgx.TxOptions, fn func(ctx context.Context) error) ([]string, error) {
+	db := &fakeDB{}
+	err := run(context.Background(), &postgres.Transaction{Tx: &fakeTx{db: db}}, txOpts, fn)
+
+	return db.statements, err
+}
+
+var readCommitted = pgx.TxOptions{IsoLevel: pgx.ReadCommitted, AccessMode: pgx.ReadWrite}
+
+func TestNestedSuccessReleasesSavepoint(t *testing.T) {
+	m := New(nil)
+
+	statements, err := inTx(readCommitted, func(ctx context.Context) error {
+		return m.RunReadCommitted(ctx, pgx.ReadWrite, func(ctx context.Context) error {
+			return nil
+		})
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"SAVEPOINT sp1", "RELEASE SAVEPOINT sp1", "COMMIT"}, statements)
+}
+
+func TestNestedErrorRollsBackToSavepointOnly(t *testing.T) {
+	m := New(nil)
+
+	statements, err := inTx(readCommitted, func(ctx context.Context) error {
+		err := m.RunReadCommitted(ctx, pgx.ReadWrite, func(ctx context.Context) error {
+			return errBoom
+		})
+		require.ErrorIs(t, err, errBoom)
+
+		// outer transaction tolerates error of nested call and goes on
+		return m.RunReadCommitted(ctx, pgx.ReadWrite, func(ctx context.Context) error {
+			return nil
+		})
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"SAVEPOINT sp1", "ROLLBACK TO SAVEPOINT sp1",
+		"SAVEPOINT sp2", "RELEASE SAVEPOINT sp2",
+		"COMMIT",
+	}, statements)
+}
+
+func TestNestedErrorReturnedByOuterRollsBackEverything(t *testing.T) {
+	m := New(nil)
+
+	statements, err := inTx(readCommitted, func(ctx context.Context) error {
+		return m.RunReadCommitted(ctx, pgx.ReadWrite, func(ctx context.Context) error {
+			return errBoom
+		})
+	})
+
+	require.ErrorIs(t, err, errBoom)
+	require.Equal(t, []string{"SAVEPOINT sp1", "ROLLBACK TO SAVEPOINT sp1", "ROLLBACK"}, statements)
+}
+
+func TestNestedPanicRollsBackToSavepoint(t *testing.T) {
+	m := New(nil)
+
+	statements, err := inTx(readCommitted, func(ctx context.Context) error {
+		err := m.RunReadCommitted(ctx, pgx.ReadWrite, func(ctx context.Context) error {
+			panic("nested")
+		})
+		require.ErrorContains(t, err, "panic recovered: nested")
+
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"SAVEPOINT sp1", "ROLLBACK TO SAVEPOINT sp1", "COMMIT"}, statements)
+}
+
+func TestPanicRollsBackTransaction(t *testing.T) {
+	statements, err := inTx(readCommitted, func(ctx context.Context) error {
+		panic("outer")
+	})
+
+	require.ErrorContains(t, err, "panic recovered: outer")
+	require.Equal(t, []string{"ROLLBACK"}, statements)
+}
+
+func TestNestedOptions(t *testing.T) {
+	m := New(nil)
+
+	tests := []struct {
+		name    string
+		outer   pgx.TxOptions
+		nested  func(ctx context.Context, fn func(ctx context.Context) error) error
+		wantErr error
+	}{
+		{
+			name:  "serializable inside read committed",
+			outer: readCommitted,
+			nested: func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return m.RunSerializable(ctx, pgx.ReadWrite, fn)
+			},
+			wantErr: ErrStricterIsolation,
+		},
+		{
+			name:  "repeatable read inside read committed",
+			outer: readCommitted,
+			nested: func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return m.RunRepeatableRead(ctx, pgx.ReadWrite, fn)
+			},
+			wantErr: ErrStricterIsolation,
+		},
+		{
+			name:  "read committed inside serializable",
+			outer: pgx.TxOptions{IsoLevel: pgx.Serializable, AccessMode: pgx.ReadWrite},
+			nested: func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return m.RunReadCommitted(ctx, pgx.ReadWrite, fn)
+			},
+		},
+		{
+			name:  "read write inside read only",
+			outer: pgx.TxOptions{IsoLevel: pgx.ReadCommitted, AccessMode: pgx.ReadOnly},
+			nested: func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return m.RunReadCommitted(ctx, pgx.ReadWrite, fn)
+			},
+			wantErr: ErrReadWriteInReadOnly,
+		},
+		{
+			name:  "read only inside read write",
+			outer: readCommitted,
+			nested: func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return m.RunReadCommitted(ctx, pgx.ReadOnly, fn)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			statements, err := inTx(tt.outer, func(ctx context.Context) error {
+				return tt.nested(ctx, func(ctx context.Context) error {
+					called = true
+					return nil
+				})
+			})
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.False(t, called)
+				require.Equal(t, []string{"ROLLBACK"}, statements)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, called)
+			require.Equal(t, []string{"SAVEPOINT sp1", "RELEASE SAVEPOINT sp1", "COMMIT"}, statements)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+)
+
+// DefaultMaxBodySize - limit of request body unless WithMaxBodySize is set
+const DefaultMaxBodySize = 1 << 20
+
+type options struct {
+	maxBodySize int64
+	allowEmpty  bool
+}
+
+type Option func(*options)
+
+// WithMaxBodySize - bodies larger than n bytes are rejected with 413
+func WithMaxBodySize(n int64) Option {
+	return func(o *options) {
+		o.maxBodySize = n
+	}
+}
+
+// AllowEmptyBody - empty body is not an error, dst is left as is and validated
+func AllowEmptyBody() Option {
+	return func(o *options) {
+		o.allowEmpty = true
+	}
+}
+
+// DecodeJSON - decodes single JSON object from body of r into dst and validates it.
+// Unknown fields, malformed JSON and oversized bodies are rejected, returned error is *problem.Problem
+// meant to be written with problem.WriteError
+func DecodeJSON(w http.ResponseWriter, r *http.Request, dst interface{}, opts ...Option) error {
+	o := options{maxBodySize: DefaultMaxBodySize}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, o.maxBodySize))
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(dst); err != nil {
+		if !errors.Is(err, io.EOF) || !o.allowEmpty {
+			return decodeProblem(err)
+		}
+	} else if err = dec.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return decodeProblem(err)
+		}
+		return problem.BadRequest.New("body must contain single JSON object")
+	}
+
+	return validated(dst)
+}
+
+func decodeProblem(err error) *problem.Problem {
+	var (
+		syntaxErr   *json.SyntaxError
+		typeErr     *json.UnmarshalTypeError
+		maxBytesErr *http.MaxBytesError
+	)
+
+	switch {
+	case errors.Is(err, io.EOF):
+		return problem.BadRequest.New("request body is empty")
+	case errors.As(err, &maxBytesErr):
+		return problem.PayloadTooLarge.New(fmt.Sprintf("request body exceeds %d bytes", maxBytesErr.Limit))
+	case errors.As(err, &syntaxErr), errors.Is(err, io.ErrUnexpectedEOF):
+		return problem.BadRequest.New("malformed JSON")
+	case errors.As(err, &typeErr):
+		return invalid(Errors{{
+			Field:   typeErr.Field,
+			Rule:    "type",
+			Param:   typeErr.Value,
+			Message: "must be " + typeName(typeErr.Type.Kind()),
+		}})
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		// encoding/json has no error type for unknown fields
+		return problem.BadRequest.New(strings.TrimPrefix(err.Error(), "json: "))
+	default:
+		return problem.BadRequest.New("failed to decode body")
+	}
+}
+
+// validated - validation result of dst as problem
+func validated(dst interface{}) error {
+	err := Struct(dst)
+	if err == nil {
+		return nil
+	}
+
+	var errs Errors
+	if errors.As(err, &errs) {
+		return invalid(errs)
+	}
+
+	return problem.BadRequest.New(err.Error())
+}
+
+func invalid(errs Errors) *problem.Problem {
+	return problem.ValidationFailed.New(errs.Error()).WithDetails(errs)
+}
+
+// typeName - JSON name of go kind
+func typeName(kind reflect.Kind) string {
+	switch kind {
+	case reflect.String:
+		return "a string"
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Struct, reflect.Map:
+		return "an object"
+	case reflect.Slice, reflect.Array:
+		return "an array"
+	default:
+		return "a number"
+	}
+}
@@ -0,0 +1,153 @@
+package validation
+
+import (
+	"encoding"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Query - sets fields of struct dst from query params named by query tags and validates it.
+// Params that are absent keep values of dst, so defaults are set before the call.
+// Slice fields take all values of repeated param, returned error is *problem.Problem
+func Query(r *http.Request, dst interface{}) error {
+	query := r.URL.Query()
+	return decodeParams(dst, "query", func(name string) []string {
+		return query[name]
+	})
+}
+
+// Path - sets fields of struct dst from chi url params named by path tags and validates it
+func Path(r *http.Request, dst interface{}) error {
+	return decodeParams(dst, "path", func(name string) []string {
+		if value := chi.URLParam(r, name); value != "" {
+			return []string{value}
+		}
+		return nil
+	})
+}
+
+// Params - validates params already bound by generated server wrapper, e.g. its Params struct with validate tags
+// or struct of path params, returned error is *problem.Problem
+func Params(params interface{}) error {
+	return validated(params)
+}
+
+func decodeParams(dst interface{}, tag string, lookup func(name string) []string) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("validation: dst must be pointer to struct, got %T", dst))
+	}
+	v = v.Elem()
+
+	var errs Errors
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		name := field.Tag.Get(tag)
+		if name == "" || name == "-" || !field.IsExported() {
+			continue
+		}
+
+		values := lookup(name)
+		if len(values) == 0 {
+			continue
+		}
+
+		if err := setField(v.Field(i), values); err != nil {
+			errs = append(errs, FieldError{Field: name, Rule: "type", Param: values[0], Message: err.Error()})
+		}
+	}
+
+	if len(errs) > 0 {
+		return invalid(errs)
+	}
+
+	return validated(dst)
+}
+
+// setField - slices get every value, other fields the first one
+func setField(field reflect.Value, values []string) error {
+	if field.Kind() == reflect.Slice && !implementsTextUnmarshaler(field) {
+		slice := reflect.MakeSlice(field.Type(), len(values), len(values))
+		for i, value := range values {
+			if err := setValue(slice.Index(i), value); err != nil {
+				return err
+			}
+		}
+		field.Set(slice)
+		return nil
+	}
+
+	return setValue(field, values[0])
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func setValue(v reflect.Value, value string) error {
+	if v.Kind() == reflect.Pointer {
+		elem := reflect.New(v.Type().Elem())
+		if err := setValue(elem.Elem(), value); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	}
+
+	// uuid.UUID, time.Time, decimal.Decimal and other types with text form
+	if implementsTextUnmarshaler(v) {
+		if err := v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(value)); err != nil {
+			return fmt.Errorf("must be a valid %s", v.Type().Name())
+		}
+		return nil
+	}
+
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("must be a duration")
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("must be a boolean")
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("must be an integer")
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("must be a non-negative integer")
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("must be a number")
+		}
+		v.SetFloat(f)
+	default:
+		panic(fmt.Sprintf("validation: unsupported param type %s", v.Type()))
+	}
+
+	return nil
+}
+
+func implementsTextUnmarshaler(v reflect.Value) bool {
+	return v.CanAddr() && v.Addr().Type().Implements(reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem())
+}
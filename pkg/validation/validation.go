@@ -0,0 +1,146 @@
+// Package validation - decoding and validation of request bodies, path and query params
+// with go-playground/validator rules in validate tags
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/R1ckNash/Bank/pkg/iban"
+	"github.com/go-playground/validator/v10"
+)
+
+// tagNames - field is reported by its name in request rather than go name
+var tagNames = []string{"json", "query", "path"}
+
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		for _, tag := range tagNames {
+			name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+			if name == "-" {
+				return ""
+			}
+			if name != "" {
+				return name
+			}
+		}
+		return field.Name
+	})
+
+	// iban - account number in any spacing and case
+	_ = v.RegisterValidation("iban", func(fl validator.FieldLevel) bool {
+		return iban.Validate(iban.Normalize(fl.Field().String())) == nil
+	})
+
+	return v
+}
+
+// FieldError - rule that field of request failed, Field is path in request e.g. rates[0].base
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
+}
+
+// Errors - all failed rules of request
+type Errors []FieldError
+
+func (e Errors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, fe := range e {
+		msgs = append(msgs, fe.Field+" "+fe.Message)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Struct - validates v by its validate tags, returns Errors if any rule failed
+func Struct(v interface{}) error {
+	err := validate.Struct(v)
+	if err == nil {
+		return nil
+	}
+
+	validationErrs, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return err
+	}
+
+	errs := make(Errors, 0, len(validationErrs))
+	for _, fe := range validationErrs {
+		errs = append(errs, FieldError{
+			Field:   fieldPath(fe),
+			Rule:    fe.Tag(),
+			Param:   fe.Param(),
+			Message: message(fe),
+		})
+	}
+
+	return errs
+}
+
+// fieldPath - namespace without name of root struct
+func fieldPath(fe validator.FieldError) string {
+	if _, path, ok := strings.Cut(fe.Namespace(), "."); ok {
+		return path
+	}
+	return fe.Field()
+}
+
+func message(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "required_without":
+		return "is required when " + fe.Param() + " is not set"
+	case "min", "max", "len":
+		return sizeMessage(fe)
+	case "gt", "gte", "lt", "lte":
+		return fmt.Sprintf("must be %s %s", comparisons[fe.Tag()], fe.Param())
+	case "oneof":
+		return "must be one of: " + strings.Join(strings.Fields(fe.Param()), ", ")
+	case "email":
+		return "must be a valid email"
+	case "uuid", "uuid4":
+		return "must be a valid UUID"
+	case "numeric", "number":
+		return "must be a number"
+	case "iso4217":
+		return "must be ISO 4217 currency code"
+	case "iban":
+		return "must be a valid IBAN"
+	case "alpha":
+		return "must contain only letters"
+	default:
+		return "failed on " + fe.Tag() + " rule"
+	}
+}
+
+var comparisons = map[string]string{
+	"gt":  "greater than",
+	"gte": "at least",
+	"lt":  "less than",
+	"lte": "at most",
+}
+
+// sizeMessage - min, max and len are length for strings and collections, value for numbers
+func sizeMessage(fe validator.FieldError) string {
+	bound := map[string]string{"min": "at least", "max": "at most", "len": "exactly"}[fe.Tag()]
+
+	switch fe.Kind() {
+	case reflect.String:
+		return fmt.Sprintf("must be %s %s characters long", bound, fe.Param())
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return fmt.Sprintf("must contain %s %s items", bound, fe.Param())
+	default:
+		if fe.Tag() == "len" {
+			return "must be " + fe.Param()
+		}
+		return fmt.Sprintf("must be %s %s", bound, fe.Param())
+	}
+}
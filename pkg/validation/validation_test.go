@@ -0,0 +1,194 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type item struct {
+	Name string `json:"name" validate:"required"`
+}
+
+type request struct {
+	Number   string `json:"number" validate:"required,iban"`
+	Currency string `json:"currency" validate:"omitempty,len=3"`
+	Amount   int    `json:"amount" validate:"gt=0"`
+	Items    []item `json:"items" validate:"max=2,dive"`
+}
+
+// requireProblem - err is problem of kind with details of failed fields, when fields are given
+func requireProblem(t *testing.T, err error, kind problem.Kind, fields ...string) {
+	t.Helper()
+
+	var p *problem.Problem
+	require.True(t, errors.As(err, &p), "error %v is not problem", err)
+	require.Equal(t, kind.Code, p.Code)
+
+	if len(fields) == 0 {
+		return
+	}
+	errs, ok := p.Details.(Errors)
+	require.True(t, ok)
+	got := make([]string, 0, len(errs))
+	for _, fe := range errs {
+		got = append(got, fe.Field)
+	}
+	require.ElementsMatch(t, fields, got)
+}
+
+func TestDecodeJSON(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		opts       []Option
+		wantKind   *problem.Kind
+		wantFields []string
+	}{
+		{name: "valid", body: `{"number": "de89 3704 0044 0532 0130 00", "amount": 10, "items": [{"name": "a"}]}`},
+		{name: "empty body", body: ``, wantKind: &problem.BadRequest},
+		{name: "empty body allowed is validated", body: ``, opts: []Option{AllowEmptyBody()}, wantKind: &problem.ValidationFailed, wantFields: []string{"number", "amount"}},
+		{name: "malformed", body: `{"number": `, wantKind: &problem.BadRequest},
+		{name: "unknown field", body: `{"number": "DE89370400440532013000", "amount": 1, "extra": 1}`, wantKind: &problem.BadRequest},
+		{name: "two objects", body: `{"number": "DE89370400440532013000", "amount": 1} {}`, wantKind: &problem.BadRequest},
+		{name: "wrong type", body: `{"number": "DE89370400440532013000", "amount": "ten"}`, wantKind: &problem.ValidationFailed, wantFields: []string{"amount"}},
+		{name: "too large", body: `{"number": "` + strings.Repeat("1", 100) + `"}`, opts: []Option{WithMaxBodySize(50)}, wantKind: &problem.PayloadTooLarge},
+		{
+			name:       "failed rules",
+			body:       `{"number": "DE00370400440532013000", "currency": "EURO", "amount": 0, "items": [{"name": "a"}, {}]}`,
+			wantKind:   &problem.ValidationFailed,
+			wantFields: []string{"number", "currency", "amount", "items[1].name"},
+		},
+		{name: "too many items", body: `{"number": "DE89370400440532013000", "amount": 1, "items": [{"name": "a"}, {"name": "b"}, {"name": "c"}]}`,
+			wantKind: &problem.ValidationFailed, wantFields: []string{"items"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+
+			var req request
+			err := DecodeJSON(httptest.NewRecorder(), r, &req, tt.opts...)
+			if tt.wantKind == nil {
+				require.NoError(t, err)
+				return
+			}
+			requireProblem(t, err, *tt.wantKind, tt.wantFields...)
+		})
+	}
+}
+
+func TestStructMessages(t *testing.T) {
+	err := Struct(request{Number: "DE89370400440532013000", Currency: "EURO", Amount: 0, Items: make([]item, 3)})
+
+	var errs Errors
+	require.ErrorAs(t, err, &errs)
+
+	messages := make(map[string]string, len(errs))
+	for _, fe := range errs {
+		messages[fe.Field] = fe.Message
+	}
+	require.Equal(t, map[string]string{
+		"currency": "must be exactly 3 characters long",
+		"amount":   "must be greater than 0",
+		"items":    "must contain at most 2 items",
+	}, messages)
+}
+
+type params struct {
+	ID     string   `path:"id" validate:"required,uuid"`
+	Limit  int      `query:"limit" validate:"min=1,max=100"`
+	Active *bool    `query:"active"`
+	Types  []string `query:"type"`
+}
+
+func TestQuery(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		want       params
+		wantFields []string
+	}{
+		{name: "defaults are kept", query: "", want: params{Limit: 20}},
+		{name: "values are set", query: "limit=5&active=true&type=a&type=b", want: params{Limit: 5, Active: ptr(true), Types: []string{"a", "b"}}},
+		{name: "wrong type", query: "limit=many&active=maybe", wantFields: []string{"limit", "active"}},
+		{name: "failed rule", query: "limit=500", wantFields: []string{"limit"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/?"+tt.query, nil)
+
+			got := params{ID: "0b8e7f5c-5d43-4c41-9a3a-3f4a6f2f7a10", Limit: 20}
+			err := Query(r, &got)
+			if tt.wantFields != nil {
+				requireProblem(t, err, problem.ValidationFailed, tt.wantFields...)
+				return
+			}
+			require.NoError(t, err)
+
+			tt.want.ID = got.ID
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+type pathParams struct {
+	ID     uuid.UUID     `path:"id"`
+	Window time.Duration `path:"window"`
+}
+
+func TestPath(t *testing.T) {
+	id := uuid.New()
+
+	tests := []struct {
+		name       string
+		params     map[string]string
+		want       pathParams
+		wantFields []string
+	}{
+		{name: "values are set", params: map[string]string{"id": id.String(), "window": "90s"}, want: pathParams{ID: id, Window: 90 * time.Second}},
+		{name: "absent param is kept", params: map[string]string{"id": id.String()}, want: pathParams{ID: id, Window: time.Minute}},
+		{name: "wrong type", params: map[string]string{"id": "42", "window": "soon"}, wantFields: []string{"id", "window"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rctx := chi.NewRouteContext()
+			for key, value := range tt.params {
+				rctx.URLParams.Add(key, value)
+			}
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			got := pathParams{Window: time.Minute}
+			err := Path(r, &got)
+			if tt.wantFields != nil {
+				requireProblem(t, err, problem.ValidationFailed, tt.wantFields...)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParams(t *testing.T) {
+	require.NoError(t, Params(&params{ID: "0b8e7f5c-5d43-4c41-9a3a-3f4a6f2f7a10", Limit: 1}))
+
+	// fields are named by their path and query tags
+	requireProblem(t, Params(&params{ID: "42"}), problem.ValidationFailed, "id", "limit")
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,1919 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	BearerAuthScopes  = "bearerAuth.Scopes"
+	ServiceAuthScopes = "serviceAuth.Scopes"
+)
+
+// Defines values for AccountProduct.
+const (
+	AccountProductChecking AccountProduct = "checking"
+	AccountProductSavings  AccountProduct = "savings"
+)
+
+// Defines values for AccountStatus.
+const (
+	AccountStatusActive  AccountStatus = "active"
+	AccountStatusBlocked AccountStatus = "blocked"
+	AccountStatusClosed  AccountStatus = "closed"
+	AccountStatusFrozen  AccountStatus = "frozen"
+)
+
+// Defines values for CreateAccountRequestProduct.
+const (
+	CreateAccountRequestProductChecking CreateAccountRequestProduct = "checking"
+	CreateAccountRequestProductSavings  CreateAccountRequestProduct = "savings"
+)
+
+// Defines values for HoldStatus.
+const (
+	HoldStatusActive   HoldStatus = "active"
+	HoldStatusCaptured HoldStatus = "captured"
+	HoldStatusExpired  HoldStatus = "expired"
+	HoldStatusReleased HoldStatus = "released"
+)
+
+// Defines values for StatusChangeRequestReasonCode.
+const (
+	ComplianceReview StatusChangeRequestReasonCode = "compliance_review"
+	CourtOrder       StatusChangeRequestReasonCode = "court_order"
+	CustomerRequest  StatusChangeRequestReasonCode = "customer_request"
+	FraudSuspected   StatusChangeRequestReasonCode = "fraud_suspected"
+	IssueResolved    StatusChangeRequestReasonCode = "issue_resolved"
+	OwnerClosure     StatusChangeRequestReasonCode = "owner_closure"
+)
+
+// Defines values for TransactionType.
+const (
+	HoldCapture       TransactionType = "hold_capture"
+	Interest          TransactionType = "interest"
+	OverdraftInterest TransactionType = "overdraft_interest"
+	Transfer          TransactionType = "transfer"
+)
+
+// Defines values for GetStatementParamsFormat.
+const (
+	Csv  GetStatementParamsFormat = "csv"
+	Json GetStatementParamsFormat = "json"
+	Pdf  GetStatementParamsFormat = "pdf"
+)
+
+// Account defines model for Account.
+type Account struct {
+	AvailableBalance *string            `json:"available_balance,omitempty"`
+	Balance          *string            `json:"balance,omitempty"`
+	Currency         string             `json:"currency"`
+	Email            string             `json:"email"`
+	Name             string             `json:"name"`
+	Number           string             `json:"number"`
+	OverdraftLimit   *string            `json:"overdraft_limit,omitempty"`
+	OwnerId          openapi_types.UUID `json:"owner_id"`
+	Product          AccountProduct     `json:"product"`
+	Status           *AccountStatus     `json:"status,omitempty"`
+}
+
+// AccountProduct defines model for Account.Product.
+type AccountProduct string
+
+// AccountLimits defines model for AccountLimits.
+type AccountLimits struct {
+	AccountNumber string    `json:"account_number"`
+	CalculatedAt  time.Time `json:"calculated_at"`
+	Currency      string    `json:"currency"`
+	DailyUsed     string    `json:"daily_used"`
+	Effective     Limits    `json:"effective"`
+	MonthlyUsed   string    `json:"monthly_used"`
+	Override      Limits    `json:"override"`
+	Product       string    `json:"product"`
+}
+
+// AccountList defines model for AccountList.
+type AccountList struct {
+	Accounts []Account `json:"accounts"`
+	Limit    int       `json:"limit"`
+	Offset   int       `json:"offset"`
+	Total    int       `json:"total"`
+}
+
+// AccountResult defines model for AccountResult.
+type AccountResult struct {
+	Account Account `json:"account"`
+	Status  string  `json:"status"`
+}
+
+// AccountStatus defines model for AccountStatus.
+type AccountStatus string
+
+// CaptureHoldRequest defines model for CaptureHoldRequest.
+type CaptureHoldRequest struct {
+	// Amount at most amount of hold
+	Amount *string `json:"amount,omitempty"`
+}
+
+// CreateAccountRequest defines model for CreateAccountRequest.
+type CreateAccountRequest struct {
+	Currency string               `json:"currency"`
+	Email    *openapi_types.Email `json:"email,omitempty"`
+	Name     string               `json:"name"`
+
+	// OwnerId ignored, owner is authenticated user
+	OwnerId *openapi_types.UUID          `json:"owner_id,omitempty"`
+	Product *CreateAccountRequestProduct `json:"product,omitempty"`
+}
+
+// CreateAccountRequestProduct defines model for CreateAccountRequest.Product.
+type CreateAccountRequestProduct string
+
+// Entry defines model for Entry.
+type Entry struct {
+	// Amount negative for debits
+	Amount        string             `json:"amount"`
+	BalanceAfter  string             `json:"balance_after"`
+	Counterparty  *string            `json:"counterparty,omitempty"`
+	CreatedAt     time.Time          `json:"created_at"`
+	Currency      string             `json:"currency"`
+	Description   *string            `json:"description,omitempty"`
+	TransactionId openapi_types.UUID `json:"transaction_id"`
+	Type          TransactionType    `json:"type"`
+}
+
+// FXQuote defines model for FXQuote.
+type FXQuote struct {
+	Amount          *string            `json:"amount,omitempty"`
+	ConvertedAmount *string            `json:"converted_amount,omitempty"`
+	ExpiresAt       time.Time          `json:"expires_at"`
+	From            string             `json:"from"`
+	Id              openapi_types.UUID `json:"id"`
+	MidRate         string             `json:"mid_rate"`
+	Rate            string             `json:"rate"`
+	Spread          string             `json:"spread"`
+	To              string             `json:"to"`
+}
+
+// FXQuoteRequest defines model for FXQuoteRequest.
+type FXQuoteRequest struct {
+	// Amount converted_amount is returned when set
+	Amount *string `json:"amount,omitempty"`
+	From   string  `json:"from"`
+	To     string  `json:"to"`
+}
+
+// FXQuoteResult defines model for FXQuoteResult.
+type FXQuoteResult struct {
+	Quote  FXQuote `json:"quote"`
+	Status string  `json:"status"`
+}
+
+// FXRate defines model for FXRate.
+type FXRate struct {
+	Base  string `json:"base"`
+	Quote string `json:"quote"`
+	Rate  string `json:"rate"`
+}
+
+// FXRateSnapshot defines model for FXRateSnapshot.
+type FXRateSnapshot struct {
+	CreatedBy string    `json:"created_by"`
+	Id        int64     `json:"id"`
+	Rates     []FXRate  `json:"rates"`
+	Source    string    `json:"source"`
+	ValidFrom time.Time `json:"valid_from"`
+	ValidTo   time.Time `json:"valid_to"`
+}
+
+// FXRateSnapshotResult defines model for FXRateSnapshotResult.
+type FXRateSnapshotResult struct {
+	Snapshot FXRateSnapshot `json:"snapshot"`
+	Status   string         `json:"status"`
+}
+
+// FXRatesRequest defines model for FXRatesRequest.
+type FXRatesRequest struct {
+	Rates     []FXRate   `json:"rates"`
+	ValidFrom *time.Time `json:"valid_from,omitempty"`
+	ValidTo   time.Time  `json:"valid_to"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	Field   string  `json:"field"`
+	Message string  `json:"message"`
+	Param   *string `json:"param,omitempty"`
+	Rule    string  `json:"rule"`
+}
+
+// Hold defines model for Hold.
+type Hold struct {
+	AccountNumber  string              `json:"account_number"`
+	Amount         string              `json:"amount"`
+	CapturedAmount string              `json:"captured_amount"`
+	CreatedAt      time.Time           `json:"created_at"`
+	Currency       string              `json:"currency"`
+	Description    *string             `json:"description,omitempty"`
+	ExpiresAt      time.Time           `json:"expires_at"`
+	Id             openapi_types.UUID  `json:"id"`
+	Reference      *string             `json:"reference,omitempty"`
+	Status         HoldStatus          `json:"status"`
+	TransactionId  *openapi_types.UUID `json:"transaction_id,omitempty"`
+}
+
+// HoldList defines model for HoldList.
+type HoldList struct {
+	Holds []Hold `json:"holds"`
+}
+
+// HoldResult defines model for HoldResult.
+type HoldResult struct {
+	Hold   Hold   `json:"hold"`
+	Status string `json:"status"`
+}
+
+// HoldStatus defines model for HoldStatus.
+type HoldStatus string
+
+// Limits defines model for Limits.
+type Limits struct {
+	Daily          *string `json:"daily,omitempty"`
+	Monthly        *string `json:"monthly,omitempty"`
+	PerTransaction *string `json:"per_transaction,omitempty"`
+}
+
+// LimitsResult defines model for LimitsResult.
+type LimitsResult struct {
+	Limits AccountLimits `json:"limits"`
+	Status string        `json:"status"`
+}
+
+// OverdraftRequest defines model for OverdraftRequest.
+type OverdraftRequest struct {
+	// Limit in account currency
+	Limit string `json:"limit"`
+
+	// Rate annual interest rate
+	Rate string `json:"rate"`
+}
+
+// PlaceHoldRequest defines model for PlaceHoldRequest.
+type PlaceHoldRequest struct {
+	Amount      string  `json:"amount"`
+	Description *string `json:"description,omitempty"`
+
+	// ExpiresAt default expiry of service when omitted
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Reference *string    `json:"reference,omitempty"`
+}
+
+// Problem RFC 7807 problem details
+type Problem struct {
+	Code      string        `json:"code"`
+	Detail    *string       `json:"detail,omitempty"`
+	Details   *[]FieldError `json:"details,omitempty"`
+	Instance  *string       `json:"instance,omitempty"`
+	RequestId *string       `json:"request_id,omitempty"`
+	Status    int           `json:"status"`
+	Title     string        `json:"title"`
+	Type      string        `json:"type"`
+}
+
+// SetLimitsRequest omitted limit falls back to limit of product
+type SetLimitsRequest struct {
+	Daily          *string `json:"daily,omitempty"`
+	Monthly        *string `json:"monthly,omitempty"`
+	PerTransaction *string `json:"per_transaction,omitempty"`
+}
+
+// Statement defines model for Statement.
+type Statement struct {
+	AccountName    string    `json:"account_name"`
+	AccountNumber  string    `json:"account_number"`
+	ClosingBalance string    `json:"closing_balance"`
+	Currency       string    `json:"currency"`
+	Entries        []Entry   `json:"entries"`
+	GeneratedAt    time.Time `json:"generated_at"`
+	Month          string    `json:"month"`
+	OpeningBalance string    `json:"opening_balance"`
+	PeriodEnd      time.Time `json:"period_end"`
+	PeriodStart    time.Time `json:"period_start"`
+	TotalCredits   string    `json:"total_credits"`
+	TotalDebits    string    `json:"total_debits"`
+}
+
+// Status defines model for Status.
+type Status struct {
+	Status string `json:"status"`
+}
+
+// StatusChangeRequest defines model for StatusChangeRequest.
+type StatusChangeRequest struct {
+	Comment    *string                       `json:"comment,omitempty"`
+	ReasonCode StatusChangeRequestReasonCode `json:"reason_code"`
+}
+
+// StatusChangeRequestReasonCode defines model for StatusChangeRequest.ReasonCode.
+type StatusChangeRequestReasonCode string
+
+// Transaction defines model for Transaction.
+type Transaction struct {
+	CreatedAt      time.Time          `json:"created_at"`
+	CreditAmount   string             `json:"credit_amount"`
+	CreditCurrency string             `json:"credit_currency"`
+	DebitAmount    string             `json:"debit_amount"`
+	DebitCurrency  string             `json:"debit_currency"`
+	Description    *string            `json:"description,omitempty"`
+	From           *string            `json:"from,omitempty"`
+	FxMidRate      *string            `json:"fx_mid_rate,omitempty"`
+	FxRate         *string            `json:"fx_rate,omitempty"`
+	FxSpread       *string            `json:"fx_spread,omitempty"`
+	Id             openapi_types.UUID `json:"id"`
+	To             *string            `json:"to,omitempty"`
+	Type           TransactionType    `json:"type"`
+}
+
+// TransactionPage defines model for TransactionPage.
+type TransactionPage struct {
+	Entries    []Entry `json:"entries"`
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// TransactionResult defines model for TransactionResult.
+type TransactionResult struct {
+	Status      string      `json:"status"`
+	Transaction Transaction `json:"transaction"`
+}
+
+// TransactionType defines model for TransactionType.
+type TransactionType string
+
+// TransferRequest defines model for TransferRequest.
+type TransferRequest struct {
+	// Amount decimal in currency of from account
+	Amount      string  `json:"amount"`
+	Description *string `json:"description,omitempty"`
+
+	// From IBAN
+	From string `json:"from"`
+
+	// InitiatedBy owner of from account, only for services
+	InitiatedBy *openapi_types.UUID `json:"initiated_by,omitempty"`
+
+	// QuoteId required when currencies differ
+	QuoteId *openapi_types.UUID `json:"quote_id,omitempty"`
+
+	// To IBAN
+	To string `json:"to"`
+}
+
+// AccountNumber defines model for AccountNumber.
+type AccountNumber = string
+
+// HoldID defines model for HoldID.
+type HoldID = openapi_types.UUID
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// Limit defines model for Limit.
+type Limit = int
+
+// BadRequest RFC 7807 problem details
+type BadRequest = Problem
+
+// Conflict RFC 7807 problem details
+type Conflict = Problem
+
+// Forbidden RFC 7807 problem details
+type Forbidden = Problem
+
+// NotFound RFC 7807 problem details
+type NotFound = Problem
+
+// OK defines model for OK.
+type OK = Status
+
+// Unauthorized RFC 7807 problem details
+type Unauthorized = Problem
+
+// Unavailable RFC 7807 problem details
+type Unavailable = Problem
+
+// Unprocessable RFC 7807 problem details
+type Unprocessable = Problem
+
+// StatusChange defines model for StatusChange.
+type StatusChange = StatusChangeRequest
+
+// ListAccountsParams defines parameters for ListAccounts.
+type ListAccountsParams struct {
+	Currency *string        `form:"currency,omitempty" json:"currency,omitempty" validate:"omitempty,len=3"`
+	Status   *AccountStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit    *Limit         `form:"limit,omitempty" json:"limit,omitempty" validate:"omitempty,min=1,max=100"`
+	Offset   *int           `form:"offset,omitempty" json:"offset,omitempty" validate:"omitempty,min=0"`
+}
+
+// TransferParams defines parameters for Transfer.
+type TransferParams struct {
+	// IdempotencyKey Repeated request of the same caller with the same key returns result of the first one, key reused for different request is rejected with 422
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// ListHoldsParams defines parameters for ListHolds.
+type ListHoldsParams struct {
+	Status *HoldStatus `form:"status,omitempty" json:"status,omitempty" validate:"omitempty,oneof=active captured released expired"`
+}
+
+// GetStatementParams defines parameters for GetStatement.
+type GetStatementParams struct {
+	// Month YYYY-MM
+	Month  string                    `form:"month" json:"month" validate:"required"`
+	Format *GetStatementParamsFormat `form:"format,omitempty" json:"format,omitempty" validate:"omitempty,oneof=json csv pdf"`
+}
+
+// GetStatementParamsFormat defines parameters for GetStatement.
+type GetStatementParamsFormat string
+
+// ListTransactionsParams defines parameters for ListTransactions.
+type ListTransactionsParams struct {
+	// Cursor next_cursor of previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty" validate:"omitempty,min=1,max=100"`
+
+	// From YYYY-MM-DD or RFC 3339
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To YYYY-MM-DD (inclusive) or RFC 3339
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+
+	// Type comma separated transaction types
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
+}
+
+// PlaceHoldParams defines parameters for PlaceHold.
+type PlaceHoldParams struct {
+	// IdempotencyKey Repeated request of the same caller with the same key returns result of the first one, key reused for different request is rejected with 422
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// InternalTransferParams defines parameters for InternalTransfer.
+type InternalTransferParams struct {
+	// IdempotencyKey Repeated request of the same caller with the same key returns result of the first one, key reused for different request is rejected with 422
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// CreateAccountJSONRequestBody defines body for CreateAccount for application/json ContentType.
+type CreateAccountJSONRequestBody = CreateAccountRequest
+
+// TransferJSONRequestBody defines body for Transfer for application/json ContentType.
+type TransferJSONRequestBody = TransferRequest
+
+// BlockAccountJSONRequestBody defines body for BlockAccount for application/json ContentType.
+type BlockAccountJSONRequestBody = StatusChangeRequest
+
+// FreezeAccountJSONRequestBody defines body for FreezeAccount for application/json ContentType.
+type FreezeAccountJSONRequestBody = StatusChangeRequest
+
+// SetLimitsJSONRequestBody defines body for SetLimits for application/json ContentType.
+type SetLimitsJSONRequestBody = SetLimitsRequest
+
+// SetOverdraftJSONRequestBody defines body for SetOverdraft for application/json ContentType.
+type SetOverdraftJSONRequestBody = OverdraftRequest
+
+// UnblockAccountJSONRequestBody defines body for UnblockAccount for application/json ContentType.
+type UnblockAccountJSONRequestBody = StatusChangeRequest
+
+// UnfreezeAccountJSONRequestBody defines body for UnfreezeAccount for application/json ContentType.
+type UnfreezeAccountJSONRequestBody = StatusChangeRequest
+
+// CreateFXQuoteJSONRequestBody defines body for CreateFXQuote for application/json ContentType.
+type CreateFXQuoteJSONRequestBody = FXQuoteRequest
+
+// UploadFXRatesJSONRequestBody defines body for UploadFXRates for application/json ContentType.
+type UploadFXRatesJSONRequestBody = FXRatesRequest
+
+// PlaceHoldJSONRequestBody defines body for PlaceHold for application/json ContentType.
+type PlaceHoldJSONRequestBody = PlaceHoldRequest
+
+// CaptureHoldJSONRequestBody defines body for CaptureHold for application/json ContentType.
+type CaptureHoldJSONRequestBody = CaptureHoldRequest
+
+// InternalTransferJSONRequestBody defines body for InternalTransfer for application/json ContentType.
+type InternalTransferJSONRequestBody = TransferRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Accounts of authenticated user
+	// (GET /account)
+	ListAccounts(w http.ResponseWriter, r *http.Request, params ListAccountsParams)
+	// Open account for authenticated user
+	// (POST /account/create)
+	CreateAccount(w http.ResponseWriter, r *http.Request)
+	// Release active hold (operators)
+	// (POST /account/holds/{holdID}/release)
+	ReleaseHold(w http.ResponseWriter, r *http.Request, holdID HoldID)
+	// Transfer between own accounts, converted with FX quote when currencies differ
+	// (POST /account/transfers)
+	Transfer(w http.ResponseWriter, r *http.Request, params TransferParams)
+	// Account with balances
+	// (GET /account/{accountNumber})
+	GetAccount(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber)
+	// Block account (operators)
+	// (POST /account/{accountNumber}/block)
+	BlockAccount(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber)
+	// Close account with zero balance
+	// (POST /account/{accountNumber}/close)
+	CloseAccount(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber)
+	// Freeze account (operators)
+	// (POST /account/{accountNumber}/freeze)
+	FreezeAccount(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber)
+	// Holds of account
+	// (GET /account/{accountNumber}/holds)
+	ListHolds(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber, params ListHoldsParams)
+	// Effective outgoing limits and their usage
+	// (GET /account/{accountNumber}/limits)
+	GetLimits(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber)
+	// Override outgoing limits of account (operators)
+	// (PUT /account/{accountNumber}/limits)
+	SetLimits(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber)
+	// Approve overdraft for account (operators)
+	// (PUT /account/{accountNumber}/overdraft)
+	SetOverdraft(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber)
+	// Monthly statement
+	// (GET /account/{accountNumber}/statement)
+	GetStatement(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber, params GetStatementParams)
+	// Account history, newest first
+	// (GET /account/{accountNumber}/transactions)
+	ListTransactions(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber, params ListTransactionsParams)
+	// Unblock account (operators)
+	// (POST /account/{accountNumber}/unblock)
+	UnblockAccount(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber)
+	// Unfreeze account (operators)
+	// (POST /account/{accountNumber}/unfreeze)
+	UnfreezeAccount(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber)
+	// Quote for conversion, valid until expires_at
+	// (POST /fx/quotes)
+	CreateFXQuote(w http.ResponseWriter, r *http.Request)
+	// Upload snapshot of mid-market rates (operators)
+	// (POST /fx/rates)
+	UploadFXRates(w http.ResponseWriter, r *http.Request)
+	// Account for services
+	// (GET /internal/account/{accountNumber})
+	InternalGetAccount(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber)
+	// Reserve funds on account
+	// (POST /internal/account/{accountNumber}/holds)
+	PlaceHold(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber, params PlaceHoldParams)
+	// Capture hold, whole amount unless amount is given
+	// (POST /internal/holds/{holdID}/capture)
+	CaptureHold(w http.ResponseWriter, r *http.Request, holdID HoldID)
+	// Release active hold
+	// (POST /internal/holds/{holdID}/release)
+	InternalReleaseHold(w http.ResponseWriter, r *http.Request, holdID HoldID)
+	// Transfer on behalf of owner of source account
+	// (POST /internal/transfers)
+	InternalTransfer(w http.ResponseWriter, r *http.Request, params InternalTransferParams)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Accounts of authenticated user
+// (GET /account)
+func (_ Unimplemented) ListAccounts(w http.ResponseWriter, r *http.Request, params ListAccountsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Open account for authenticated user
+// (POST /account/create)
+func (_ Unimplemented) CreateAccount(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Release active hold (operators)
+// (POST /account/holds/{holdID}/release)
+func (_ Unimplemented) ReleaseHold(w http.ResponseWriter, r *http.Request, holdID HoldID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Transfer between own accounts, converted with FX quote when currencies differ
+// (POST /account/transfers)
+func (_ Unimplemented) Transfer(w http.ResponseWriter, r *http.Request, params TransferParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Account with balances
+// (GET /account/{accountNumber})
+func (_ Unimplemented) GetAccount(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Block account (operators)
+// (POST /account/{accountNumber}/block)
+func (_ Unimplemented) BlockAccount(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Close account with zero balance
+// (POST /account/{accountNumber}/close)
+func (_ Unimplemented) CloseAccount(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Freeze account (operators)
+// (POST /account/{accountNumber}/freeze)
+func (_ Unimplemented) FreezeAccount(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Holds of account
+// (GET /account/{accountNumber}/holds)
+func (_ Unimplemented) ListHolds(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber, params ListHoldsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Effective outgoing limits and their usage
+// (GET /account/{accountNumber}/limits)
+func (_ Unimplemented) GetLimits(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Override outgoing limits of account (operators)
+// (PUT /account/{accountNumber}/limits)
+func (_ Unimplemented) SetLimits(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Approve overdraft for account (operators)
+// (PUT /account/{accountNumber}/overdraft)
+func (_ Unimplemented) SetOverdraft(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Monthly statement
+// (GET /account/{accountNumber}/statement)
+func (_ Unimplemented) GetStatement(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber, params GetStatementParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Account history, newest first
+// (GET /account/{accountNumber}/transactions)
+func (_ Unimplemented) ListTransactions(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber, params ListTransactionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unblock account (operators)
+// (POST /account/{accountNumber}/unblock)
+func (_ Unimplemented) UnblockAccount(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unfreeze account (operators)
+// (POST /account/{accountNumber}/unfreeze)
+func (_ Unimplemented) UnfreezeAccount(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Quote for conversion, valid until expires_at
+// (POST /fx/quotes)
+func (_ Unimplemented) CreateFXQuote(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload snapshot of mid-market rates (operators)
+// (POST /fx/rates)
+func (_ Unimplemented) UploadFXRates(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Account for services
+// (GET /internal/account/{accountNumber})
+func (_ Unimplemented) InternalGetAccount(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reserve funds on account
+// (POST /internal/account/{accountNumber}/holds)
+func (_ Unimplemented) PlaceHold(w http.ResponseWriter, r *http.Request, accountNumber AccountNumber, params PlaceHoldParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Capture hold, whole amount unless amount is given
+// (POST /internal/holds/{holdID}/capture)
+func (_ Unimplemented) CaptureHold(w http.ResponseWriter, r *http.Request, holdID HoldID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Release active hold
+// (POST /internal/holds/{holdID}/release)
+func (_ Unimplemented) InternalReleaseHold(w http.ResponseWriter, r *http.Request, holdID HoldID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Transfer on behalf of owner of source account
+// (POST /internal/transfers)
+func (_ Unimplemented) InternalTransfer(w http.ResponseWriter, r *http.Request, params InternalTransferParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListAccounts operation middleware
+func (siw *ServerInterfaceWrapper) ListAccounts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAccountsParams
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAccounts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateAccount operation middleware
+func (siw *ServerInterfaceWrapper) CreateAccount(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAccount(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReleaseHold operation middleware
+func (siw *ServerInterfaceWrapper) ReleaseHold(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "holdID" -------------
+	var holdID HoldID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "holdID", chi.URLParam(r, "holdID"), &holdID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "holdID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReleaseHold(w, r, holdID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Transfer operation middleware
+func (siw *ServerInterfaceWrapper) Transfer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TransferParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Transfer(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAccount operation middleware
+func (siw *ServerInterfaceWrapper) GetAccount(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "accountNumber" -------------
+	var accountNumber AccountNumber
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountNumber", chi.URLParam(r, "accountNumber"), &accountNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountNumber", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAccount(w, r, accountNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BlockAccount operation middleware
+func (siw *ServerInterfaceWrapper) BlockAccount(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "accountNumber" -------------
+	var accountNumber AccountNumber
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountNumber", chi.URLParam(r, "accountNumber"), &accountNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountNumber", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BlockAccount(w, r, accountNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CloseAccount operation middleware
+func (siw *ServerInterfaceWrapper) CloseAccount(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "accountNumber" -------------
+	var accountNumber AccountNumber
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountNumber", chi.URLParam(r, "accountNumber"), &accountNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountNumber", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CloseAccount(w, r, accountNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FreezeAccount operation middleware
+func (siw *ServerInterfaceWrapper) FreezeAccount(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "accountNumber" -------------
+	var accountNumber AccountNumber
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountNumber", chi.URLParam(r, "accountNumber"), &accountNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountNumber", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FreezeAccount(w, r, accountNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListHolds operation middleware
+func (siw *ServerInterfaceWrapper) ListHolds(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "accountNumber" -------------
+	var accountNumber AccountNumber
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountNumber", chi.URLParam(r, "accountNumber"), &accountNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountNumber", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListHoldsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListHolds(w, r, accountNumber, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLimits operation middleware
+func (siw *ServerInterfaceWrapper) GetLimits(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "accountNumber" -------------
+	var accountNumber AccountNumber
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountNumber", chi.URLParam(r, "accountNumber"), &accountNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountNumber", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLimits(w, r, accountNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetLimits operation middleware
+func (siw *ServerInterfaceWrapper) SetLimits(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "accountNumber" -------------
+	var accountNumber AccountNumber
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountNumber", chi.URLParam(r, "accountNumber"), &accountNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountNumber", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetLimits(w, r, accountNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetOverdraft operation middleware
+func (siw *ServerInterfaceWrapper) SetOverdraft(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "accountNumber" -------------
+	var accountNumber AccountNumber
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountNumber", chi.URLParam(r, "accountNumber"), &accountNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountNumber", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetOverdraft(w, r, accountNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatement operation middleware
+func (siw *ServerInterfaceWrapper) GetStatement(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "accountNumber" -------------
+	var accountNumber AccountNumber
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountNumber", chi.URLParam(r, "accountNumber"), &accountNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountNumber", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatementParams
+
+	// ------------- Required query parameter "month" -------------
+
+	if paramValue := r.URL.Query().Get("month"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "month"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "month", r.URL.Query(), &params.Month)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "month", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatement(w, r, accountNumber, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTransactions operation middleware
+func (siw *ServerInterfaceWrapper) ListTransactions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "accountNumber" -------------
+	var accountNumber AccountNumber
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountNumber", chi.URLParam(r, "accountNumber"), &accountNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountNumber", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTransactionsParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTransactions(w, r, accountNumber, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnblockAccount operation middleware
+func (siw *ServerInterfaceWrapper) UnblockAccount(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "accountNumber" -------------
+	var accountNumber AccountNumber
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountNumber", chi.URLParam(r, "accountNumber"), &accountNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountNumber", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnblockAccount(w, r, accountNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnfreezeAccount operation middleware
+func (siw *ServerInterfaceWrapper) UnfreezeAccount(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "accountNumber" -------------
+	var accountNumber AccountNumber
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountNumber", chi.URLParam(r, "accountNumber"), &accountNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountNumber", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnfreezeAccount(w, r, accountNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateFXQuote operation middleware
+func (siw *ServerInterfaceWrapper) CreateFXQuote(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateFXQuote(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadFXRates operation middleware
+func (siw *ServerInterfaceWrapper) UploadFXRates(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadFXRates(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// InternalGetAccount operation middleware
+func (siw *ServerInterfaceWrapper) InternalGetAccount(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "accountNumber" -------------
+	var accountNumber AccountNumber
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountNumber", chi.URLParam(r, "accountNumber"), &accountNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountNumber", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ServiceAuthScopes, []string{"accounts:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InternalGetAccount(w, r, accountNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PlaceHold operation middleware
+func (siw *ServerInterfaceWrapper) PlaceHold(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "accountNumber" -------------
+	var accountNumber AccountNumber
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountNumber", chi.URLParam(r, "accountNumber"), &accountNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountNumber", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ServiceAuthScopes, []string{"holds:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PlaceHoldParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PlaceHold(w, r, accountNumber, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CaptureHold operation middleware
+func (siw *ServerInterfaceWrapper) CaptureHold(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "holdID" -------------
+	var holdID HoldID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "holdID", chi.URLParam(r, "holdID"), &holdID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "holdID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ServiceAuthScopes, []string{"holds:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CaptureHold(w, r, holdID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// InternalReleaseHold operation middleware
+func (siw *ServerInterfaceWrapper) InternalReleaseHold(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "holdID" -------------
+	var holdID HoldID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "holdID", chi.URLParam(r, "holdID"), &holdID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "holdID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ServiceAuthScopes, []string{"holds:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InternalReleaseHold(w, r, holdID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// InternalTransfer operation middleware
+func (siw *ServerInterfaceWrapper) InternalTransfer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ServiceAuthScopes, []string{"transfers:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params InternalTransferParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InternalTransfer(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/account", wrapper.ListAccounts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/account/create", wrapper.CreateAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/account/holds/{holdID}/release", wrapper.ReleaseHold)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/account/transfers", wrapper.Transfer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/account/{accountNumber}", wrapper.GetAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/account/{accountNumber}/block", wrapper.BlockAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/account/{accountNumber}/close", wrapper.CloseAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/account/{accountNumber}/freeze", wrapper.FreezeAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/account/{accountNumber}/holds", wrapper.ListHolds)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/account/{accountNumber}/limits", wrapper.GetLimits)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/account/{accountNumber}/limits", wrapper.SetLimits)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/account/{accountNumber}/overdraft", wrapper.SetOverdraft)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/account/{accountNumber}/statement", wrapper.GetStatement)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/account/{accountNumber}/transactions", wrapper.ListTransactions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/account/{accountNumber}/unblock", wrapper.UnblockAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/account/{accountNumber}/unfreeze", wrapper.UnfreezeAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/fx/quotes", wrapper.CreateFXQuote)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/fx/rates", wrapper.UploadFXRates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/internal/account/{accountNumber}", wrapper.InternalGetAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/internal/account/{accountNumber}/holds", wrapper.PlaceHold)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/internal/holds/{holdID}/capture", wrapper.CaptureHold)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/internal/holds/{holdID}/release", wrapper.InternalReleaseHold)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/internal/transfers", wrapper.InternalTransfer)
+	})
+
+	return r
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+w9a3PbNrZ/BcO7H9q5tCXb6T480w95NLfZbprcxJndTuKrgchDCRsSYAHQtprRf7+D",
+	"FwlSICnaipt0/SkW8To45+C8gXyKElaUjAKVIjr/FJWY4wIkcP3rcZKwisqfq2IJXH1IQSSclJIwGp1H",
+	"L548/jlGosQJCIRpihIsAGEOiKwo45BGcURUxxLLdRRHFBcQnUe4NWsccfi1Iqr3ueQVxJFI1lBgtZzc",
+	"lGqAkJzQVbTdxtGPLE9fPFNtgYnXpnFoxozxAsvoPKoqosDbXeFFCkXJJNBk8xNsdjf9BkrAElKkFgEh",
+	"EcuQXAMSuACU4DwHjq6JXDcfP8IGcZAVpwJxEFVej8kIVxNQiG2nSkCKMsZRSrIMOFBZL0PU4H9DopbW",
+	"8z86PXX4XQNOgTeI8PZwpDYxjNN/kILIGqW/VsA3zVS5bvQnSCHDVS6j89N5HBX4hhRVEZ2fzNUvQu2v",
+	"GrOESlgBj7ZxdHPEcEmOEpbCCugR3EiOjyReaVa7wjlJsVRDWEEkFKXcxAWh35/EBb75/mQ+j7YKWIuO",
+	"JywloAe+lVhW4uka0xWo3wmjEqjeDy7LnCRYEW72b6Go98nbx584ZNF59F+z5gTMTKuY+ZO+MSs2yzec",
+	"Zb6IklFhgHmCU9e9H5SSs2UOxX9PA+m1GWXAaLPkS5wrvoYUMY4I1ah0fBOjFCQmuUA5EbJuzQjkqVBU",
+	"ecpolpPkXgH+mUmE85xdQ4oIRUnFNasLiSXECI5Xx8gKiQVlcoETSa5AAfuc8SVJU6C/F7TqbHKWg8K0",
+	"SFgJ+iizj0AVeD8z+ZxVNL1v6DK96DaOXv104CMQWvEZo5oY7yiu5Jpx8hvc645fEiEIXfnMXlPgHcVX",
+	"mOR4mcN9gvQMSqCpkrdKTlceEBqmkrMEhLhvqN44fbHcoGUlCAUhEK9yEPaQESqqLCMJASoXWUVTEaMU",
+	"k3yz0GJ/ATcJQAqpVhN2Qc8uUH+WnJXApRXG9b4XS5xjmkBA48TRUJuRBckm2AgFJnmwxWirUENtu+w0",
+	"sSvgKceZNLsN97mmwBck3cN2iBUu0spIUqBKDb6PkjUkH1VzHAl8RehKRJeBkcIcthFSW7TXJ9NXR+/d",
+	"Tj2YLVo8pDYwOmQ20LCl4hYFjV1H2wUiQGQnmvtRm+A8qXJlJi2wbOFO6fgjSTRY04hvGFNZSMFmyDIw",
+	"emIEi3Zb2zgqGJXroTkVh3CSTpjSY4FdQ8unVgeJfTSqN+UB00JFZxdd1A+SV8he4uq/lRm2L1NG23ol",
+	"zDneqN/dY+XZgizLBPS0SSZxHmoKo1BEbkhcW6t2+oHdv9GGeO/+J+y6ObvDFLf94nqJAeje1nM6QYId",
+	"G2Sc/QY0iqNlzpKPhuQ5U7QPCZanuJQVB+U2eYYpTlOidATOX3ubz3AuIO7io3DoaOsWLFHBhESmXVlB",
+	"yvsKelQ7m3zKAUuoCXEbsPZTE7XUMV/ifr1R4Jt/AF3JdXR+cvrXeFgRtDFhfd0Y6S5K/yuzCKhUeh1S",
+	"VAl9vqdoj9rF8vXHJJXS1Q0dPRBivR+o5JvAgehhAAorrFjSuKuwJOYg9uj6Bc5kn65Q8wMvMZdhciYc",
+	"nDg7kCbxNxJolxxToQ4co/uqfvNhWGZcNNNeqO5dInWWtZPGjgItFdFGawtHIdo+/9f/VkzCEHUDdKFX",
+	"wPWk/Z3gpiQcxCTaZJwVwcn2xHVB0gXHMmzv9TaIkgMOq3nJxqW3BkVDrvt7UNRT28VbSBkgxmHlcZda",
+	"Jl4kK05VvGgNFAmQQ9TwJOCZ2h31fvVgbMKQDjYbRA4iKKyif3WsPHTY7By3UtBmgTBkb3DoGC2xCHNd",
+	"Deu+jNqBSU/sprGD+gF7S3Ep1iyAMycflpt9Th6h8s+PolAMTwGwv21o0RUwDQWreI8HqD36hWPL/USK",
+	"GSPZviNCh9uC1ALAmzn2cegQMU6KPiYWHqnGUVgT9jbsXC/VD624nTC6LTcUhL4wI052WeN3oL9H5AG6",
+	"qpDpD5wzvktNHU5Vf8ANLspcDdQTvZ9fHtsjvKvGQAi8Cp8BnYYJtqjwTWcht5FRoauBtFM064f2qvyF",
+	"Wzn+Q/aEcUUGzYl7N/VuY7/saaZw0BmcHim3X8RH0cGFe25hl4bE3E7goTEvOwRqGZy1NPEwNmp2KvDD",
+	"YQblLO4vOdQ8u1qkszszZR8YfXJ4bTl9n+UnS149eR9EAz6+o4ROZeaATWTHYD7s5/fF6nSkKMiANmwU",
+	"bCuBLzxmC+94Z1MGhj5E5zWEe8RVmoDaZJzbdUJYf+UCvrfTdnU4q+P/U5esQt556bX32oMxpRXOEaES",
+	"uMry1h6Ek+7z45PvRg+2C3v1moevc5wcJAg0JmA9l+D0uz/HYwK3NdqFPZDus1ExJQH8iiRgHBhWECn1",
+	"SdhPTrcE8EiApxtaLHojdC7fslsY8Pwp+stf539BNo/jEq/RTuyKpR0VvpuHCe3HzNdDBbPU3qZYY8sE",
+	"jHNChexN0Ni8slVAA3otENglMg9Paj74KHHZMDHbBzkd8ulWt56nuzTmQ0R9C9JJr/3OR5v0ljORPoco",
+	"w3ku0BInH5Fk9hvLUBPV//1EtNI5UACVA8ZdXyZtn7RPzlRmdnH7BB+VnExwKUzMMsDCK6DAJ5uSGuFB",
+	"wFgJdGxnJXDC0gXQdP8V7RghMZ8Ap053LBIOqVWpPT1sWHZ6MqrFDC070KCoA3hr77vI2mWM7hY6ADeM",
+	"0KHkZQ9PVwHTp5FEjVR59dOo7LDD+ldqVwVNTFuwwp0+TyF9d3IaVF9YMLqotYU1EjOOq3QhKlHq3L6W",
+	"akWZEx0O5nBF4Fp/q7hcMJ7a3KKQrAC+sMI7iiMiRKX6C5Zf6VlMgkMRquIwnlHwoQvh6qItm8LRqElu",
+	"nmaVEedR9RjxB5fDk5gOd/Ipe+Pb2c1iMHad3Qy2DUSw981SsEHte5fkhZ+waCF5B6VdUu4SbtSv9IB5",
+	"bUMobQY7mB6hcKMBE4yPi1G36gjIvSHBPlen5flPIFS/k+TPNwLtxaZsyR89NANu3duF9VUjv57GuTO6",
+	"PtX+GfJaL+xch02FpJCQQjtVtUOm7C91LJ2n1nKxTubz4+/mYYt7mnPjTv5umXRodkKJJF5Uvj3KZJM7",
+	"cMeI0XyjU67WNRL7pJZ1+iCYvHbcYTwsiy4CwhYgR/G+QmWfLfcmgeJ+h2sbRwKSihO5eas43OZbAHPg",
+	"jyu5bn49d4D+/Z8XrmBZzWRaG2jWUpaRnlYj0E3S3sDTnIBy6DmkQCXBuTA1hoogKr3v0K8QlLNro9X0",
+	"mKfNkEgX8bGyXVlzbqR49AZwirwaFh2/Or/mRCootcceI3u6dHW9DQXpQov6EGfAm0Ev8UdA9WdDnI9A",
+	"3/E8Oo9mTAE+019a3oH6fGrqBwnNAtS0QRkRN3PHBorYuDem+v/5v5BmNHH8gb6uljlJENC0ZIRKgaQC",
+	"7e//vFAIrATwGM20aKA493qpuwMKs6qUmaK6Q8m4/EDVGnoaFxUwFNGWjK5x9AljauQN9nXpsy3eVQJE",
+	"l1sef6DaATaL9jnv6Ju+Cs1vjz/Q2sescYTe2uUfv36hskfAhcHhyfH8eO48CVyS6Dw6O54fn0WxvsSg",
+	"OWTmFR+tTHVUDe4LxTIqlvq44Rj/ssb7cPm+H8Gty0knpXqnV+3nQL8/UxwWBqnJS+1V37pT9Bju3uDC",
+	"VONFfcvb0rDgjQb/CsP8cFcY1NWFy85NgdP5/GCl2n5BX6AMWJlIWm45ztnG0aP5vG/WGsyZd5tBDzkZ",
+	"H9KqCdfCuyoKzDeeFHEidKdCyuDRq+u7VBO4QzEzNqE2m5gIHI5WVVnk3xTZHAzTwcq10MWQz09ta0UG",
+	"6P2qBFX0gZsSxfuhthp0Nj6oucOhR/xtfER9RWUbR9/ts4R/C6DNhAo3dbhe6YPbcKJWfbNP5rrZdmb1",
+	"cj9nvjEdfjQFkh2pPSLM7IW3zyo/vPxYgJ1UK9J1Zo3y/NKZ6tH4iPquznQubHGUJS4yyTttFqFvDKIY",
+	"F9963GQTlC1Waow1j3va+H/C5LqW3WiNr5Thg5aQM7pSf9k7h988mp8hJtfAr4mAbz1bTXXSDaZC1Rg8",
+	"BU6tjfT61dsLNCvxpgArne3fzpQ6NiE9j6EvGh9wGjd3Llcarj68mO76lfcsoXd9/cCx+uEGkkrJHEen",
+	"/+wzFUfqPuseG/HvUt1dHzhOQUuQ16D8u+taP4gY1VWV5qg4D6ffW3ZnvTnX7fP+qXX5edtr6f8PyMaS",
+	"mXbG2pe278PwDLH349/D+pjEoiHj1JDZZinEuCXQIedM38noNwSeqOZD0rUlOsMb965Lt641Rz0ScBh9",
+	"r356UP0102hy1tZkWOnvyTj6Cs+Ab6OaP69A+LLofo9U1LhF2BcAvwFnyMtVTqNlxgF+GyDmc93+IAb+",
+	"KGLA0PMwcqCui+yN//1oo7934prDhOba1anTY2OMAsu+t46Tq3l0Ye4UuXrH7Wd3fvsiZwbXX4kJo4H1",
+	"4nwjnmeX85r6zD6D1BZlfvn2qAU0QFDX8nVQ9Ad3+xuxSq6YevPCS7fINRCOKl3D35Dalb9u46isAnR8",
+	"e1A6Ht5136nEu2ffvVXE3MtBLh7mruQ/KMM6wGpRssOyLBvRkDXnDkmpusZAm1c9DF7XeX+hPL5Th/6l",
+	"ZRBa3nCD8gcur8MFZcnZFTS4MbmEu/O38At0+xRxU8V7dzOwTfhffvnll6OXL6M4aB662s/+5/VKLCVw",
+	"NfT/PnxIPz3aHql/Trd/iu6QWa6X600p2xqVYE430mejeT7B/kzElcJemkWXdwCta8uqyVEirpCa+PPa",
+	"rQ0TKIby51Frt6apS3iWhGK+CT/SATdyppDSGtnttyMpaihUtZWVlabSQq34ldhZL02dPRLeuZrot3kF",
+	"dcPu24Xf8dDH16tWNDcO4IqwSqDS2Ig9BSKC8eEXIidUXATFydGzZ+qxNlVjc3Z29rceSGw52CAcvdN/",
+	"Q2iSV4Jcwbd7LCXZtIUSVhQYCVBbrlM2hohIDRZ965h62P6VLu8nC6WLZAcqQ1zh6lcWrF8TIRnfxIjC",
+	"NQhpnlOdfnYrOhK2f2c6PETs/ijmmyXoYUJ2FR0L+L6zPR4Y6I/DQNktgr7ZzcwUyI4VsbnHaz6P+9l5",
+	"f+ienc/24z4BpVS/2/M1qCINrHb+TKmAIIzGyDxKW1FJctR6L8ExRnbTsATHgxzxrswZTu0zLZ+NI1qP",
+	"wNw7RwQezAn6GkzlB4T3Fs6XKo7awkJTsIZbGVwFSY8KzD+CufEveuRHzSauEn5yHckLO/ChnuQL1EHe",
+	"3RZNiNaNlPedCyOX20ufqR57NazeVSDHOY5h9uSfJu8YFkL1+xEHcFm/jBLBnRcx7lno7VFzWyoQUwSY",
+	"52TnP5UgDZrU/x2huO90fnJP4L02kK3tuzgPVYvTqhZHzr1/Eaxz6t+A6gtIP4Ohrkjt5nr7Tn6naN1d",
+	"2+wtO37q8vH2PUlbfyzZNeapQCVwPwxi343XiUn7bMVu4me3kNh7nvhulfGf4arH7svJ2+12+1CG/x97",
+	"+CxHaLEXo+u1+m9A7OGoaA5CoObp1RW5Ajr9VI5eJXH23MOVkj9edOG2KmHn/skefBe8dxJmtYfLHg+X",
+	"Pb4Gyd29Dt85J/VND0bREtY4z5RlUr+yYF7/HbGnuhD4zxC81+vZUZ86/9ed0J6P/eZf0P/k/991rQ+5",
+	"K1Wrv2Q3/q8aru3l9v8HAL7YUkHGbwAA",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -0,0 +1,4 @@
+package api
+
+// api.gen.go is generated from openapi.yaml, regenerate it after changing the spec
+//go:generate oapi-codegen -config config.yaml openapi.yaml
@@ -1,22 +1,28 @@
 package main
 
 import (
+	"account/api"
 	"account/internal/config"
-	"account/internal/http-server/handlers/get_handler"
-	"account/internal/http-server/handlers/post_handler"
+	"account/internal/http-server/handlers"
 	http_server "account/internal/http-server/server"
 	"account/internal/kafka"
-	httpdelivery "account/internal/middleware"
+	"account/internal/models"
 	"account/internal/repository/account_storage"
+	"account/internal/repository/fx_storage"
 	"account/internal/services/account"
+	"account/internal/services/fx"
 	"context"
 	"fmt"
-	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/R1ckNash/Bank/pkg/api/openapi"
+	authclient "github.com/R1ckNash/Bank/pkg/client/auth"
+	"github.com/R1ckNash/Bank/pkg/currency"
+	"github.com/R1ckNash/Bank/pkg/httpclient"
+	"github.com/R1ckNash/Bank/pkg/iban"
 	"github.com/R1ckNash/Bank/pkg/postgres"
+	"github.com/R1ckNash/Bank/pkg/resilience"
 	"github.com/R1ckNash/Bank/pkg/transaction_manager"
-	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/chi/v5/middleware"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/shopspring/decimal"
 	"log/slog"
 	"os"
 	"os/signal"
@@ -43,6 +49,10 @@ func main() {
 		postgres.WithMaxConnLifeTime(time.Hour),
 		postgres.WithMaxConnectionsCount(10),
 		postgres.WithMinConnectionsCount(5),
+		postgres.WithReplicas(cfg.DBReplicaUrls...),
+		postgres.WithSlowQueryLog(log, cfg.DBSlowQuery),
+		postgres.WithTracing(nil),
+		postgres.WithMetrics(prometheus.DefaultRegisterer),
 	)
 	if err != nil {
 		panic("db connection error")
@@ -55,36 +65,91 @@ func main() {
 	}
 	defer producer.Close()
 
+	numberGenerator, err := iban.NewGenerator(cfg.AccountNumber.CountryCode, cfg.AccountNumber.BankCode)
+	if err != nil {
+		panic("invalid account number config")
+	}
+
+	rounding := make(map[string]currency.RoundingMode, len(cfg.Currencies.Rounding))
+	for code, mode := range cfg.Currencies.Rounding {
+		rounding[code] = currency.RoundingMode(mode)
+	}
+	currencies, err := currency.NewRegistry(cfg.Currencies.Supported, rounding)
+	if err != nil {
+		panic("invalid currencies config: " + err.Error())
+	}
+
+	products := make(models.ProductCatalog, len(cfg.Products))
+	for code, p := range cfg.Products {
+		rate, err := decimal.NewFromString(p.InterestRate)
+		if err != nil || rate.IsNegative() {
+			panic("invalid interest rate of product " + code)
+		}
+		limits, err := parseProductLimits(p.Limits, currencies)
+		if err != nil {
+			panic("invalid limits of product " + code + ": " + err.Error())
+		}
+		products[code] = models.Product{Code: code, Name: p.Name, InterestRate: rate, Limits: limits}
+	}
+	if _, err = products.Get(models.ProductChecking); err != nil {
+		panic("default product is not configured: " + models.ProductChecking)
+	}
+
+	transaction_manager.RegisterMetrics()
+	resilience.RegisterMetrics()
+	handlers.RegisterProblems()
 	txManager := transaction_manager.New(pool)
 	storage := account_storage.New(txManager)
 
+	spread, err := decimal.NewFromString(cfg.FX.Spread)
+	if err != nil || spread.IsNegative() || !spread.LessThan(decimal.NewFromInt(1)) {
+		panic("invalid fx spread config")
+	}
+
+	fxService := fx.NewFXService(fx.Deps{
+		FXStorage:          fx_storage.New(txManager),
+		TransactionManager: txManager,
+		Currencies:         currencies,
+		Logger:             log,
+		Spread:             spread,
+		QuoteTTL:           cfg.FX.QuoteTTL,
+	})
+
 	accountService := account.NewAccountService(account.Deps{
 		AccountStorage:     storage,
 		TransactionManager: txManager,
 		Logger:             log,
 		EventProducer:      producer,
+		NumberGenerator:    numberGenerator,
+		Currencies:         currencies,
+		Products:           products,
+		FXQuoter:           fxService,
+		HoldDefaultTTL:     cfg.Holds.DefaultTTL,
+		HoldMaxTTL:         cfg.Holds.MaxTTL,
 	})
 
-	router := chi.NewRouter()
-
-	router.Use(
-		middleware.Recoverer,
-		middleware.Logger,
-		httpdelivery.PrometheusMiddleware,
-		middleware.Heartbeat("/ping"),
-		middleware.RequestID,
-		middleware.URLFormat,
-		auth.AuthMiddleware(cfg.JWTSecret),
+	// auth service client, attaches client-credentials token of account-service
+	authURL := fmt.Sprintf("http://%s:%d", cfg.AuthService.Host, cfg.AuthService.Port)
+	authHTTPClient := httpclient.New(
+		httpclient.WithTimeout(5*time.Second),
+		httpclient.WithTokenSource(httpclient.NewClientCredentials(authURL+"/oauth/token", cfg.AuthService.ClientID, cfg.AuthService.ClientSecret, authclient.ScopeUsersRead, authclient.ScopeTokensIntrospect)),
 	)
+	authClient := authclient.New(authURL, authHTTPClient, authclient.WithTimeout(cfg.AuthService.Timeout))
 
-	router.Route("/account", func(r chi.Router) {
-		r.Get("/{accountId}", get_handler.New(log, accountService))
-		r.Post("/create", post_handler.New(log, accountService, cfg))
-	})
+	spec, err := api.GetSwagger()
+	if err != nil {
+		panic("failed to load openapi spec: " + err.Error())
+	}
+
+	apiServer := api.ServerInterfaceWrapper{
+		Handler:          handlers.NewServer(log, accountService, fxService, authClient),
+		ErrorHandlerFunc: openapi.ParamErrorHandler,
+	}
 
-	router.Handle("/metrics", promhttp.Handler())
+	router, ir := routers(spec, apiServer, cfg.JWTSecret, cfg.ServiceAuth.Secret)
 
 	server := http_server.New(log, router, cfg.Port)
+	internalServer := http_server.New(log, ir, cfg.InternalPort)
 
 	ctx, stop := signal.NotifyContext(parent,
 		syscall.SIGINT,
@@ -92,12 +157,21 @@ func main() {
 	)
 	defer stop()
 
-	run(ctx, server, log)
+	go account.NewHoldSweeper(accountService, log, cfg.Holds.SweepInterval, cfg.Holds.SweepBatch).Run(ctx)
+	go account.NewInterestWorker(accountService, log, cfg.Interest.Interval, cfg.Interest.LookbackDays).Run(ctx)
+
+	if cfg.FX.Feed.File != "" {
+		go fx.NewFileFeed(fxService, log, cfg.FX.Feed.File, cfg.FX.Feed.Interval, cfg.FX.Feed.Validity).Run(ctx)
+	}
+
+	run(ctx, log, server, internalServer)
 }
 
-func run(ctx context.Context, server *http_server.Server, log *slog.Logger) error {
-	// Start HTTP server in a goroutine
-	go server.MustRun()
+func run(ctx context.Context, log *slog.Logger, servers ...*http_server.Server) error {
+	// Start HTTP servers in goroutines
+	for _, server := range servers {
+		go server.MustRun()
+	}
 
 	// Wait until we receive a shutdown signal
 	<-ctx.Done()
@@ -109,14 +183,66 @@ func run(ctx context.Context, server *http_server.Server, log *slog.Logger) erro
 	defer cancel()
 
 	// Attempt a graceful shutdown
-	if err := server.Stop(shutdownCtx); err != nil {
-		return fmt.Errorf("server: shutdown: %w", err)
+	for _, server := range servers {
+		if err := server.Stop(shutdownCtx); err != nil {
+			return fmt.Errorf("server: shutdown: %w", err)
+		}
 	}
 
 	log.Info("server: shutdown")
 	return nil
 }
 
+// parseProductLimits - limits by currency, every supported currency needs its own when product has any
+func parseProductLimits(cfg map[string]config.Limits, currencies *currency.Registry) (map[string]models.Limits, error) {
+	if len(cfg) == 0 {
+		return nil, nil
+	}
+
+	limits := make(map[string]models.Limits, len(cfg))
+	for code, l := range cfg {
+		cur, err := currencies.Get(code)
+		if err != nil {
+			return nil, fmt.Errorf("limits in unsupported currency %q", code)
+		}
+		if limits[cur.Code], err = parseLimits(l, cur); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, cur := range currencies.Supported() {
+		if _, ok := limits[cur.Code]; !ok {
+			return nil, fmt.Errorf("no limits in currency %s", cur.Code)
+		}
+	}
+
+	return limits, nil
+}
+
+// parseLimits - empty limit is unlimited, set one has to be in minor units of currency
+func parseLimits(cfg config.Limits, cur currency.Currency) (models.Limits, error) {
+	var limits models.Limits
+	for _, l := range []struct {
+		value string
+		dest  **decimal.Decimal
+	}{
+		{cfg.PerTransaction, &limits.PerTransaction},
+		{cfg.Daily, &limits.Daily},
+		{cfg.Monthly, &limits.Monthly},
+	} {
+		if l.value == "" {
+			continue
+		}
+		d, err := decimal.NewFromString(l.value)
+		if err != nil || d.IsNegative() || !cur.Round(d).Equal(d) {
+			return models.Limits{}, fmt.Errorf("invalid limit %q", l.value)
+		}
+		*l.dest = &d
+	}
+
+	return limits, nil
+}
+
 func setupLogger(env string) *slog.Logger {
 	var log *slog.Logger
 
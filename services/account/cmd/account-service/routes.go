@@ -0,0 +1,91 @@
+package main
+
+import (
+	"account/api"
+	httpdelivery "account/internal/middleware"
+	"github.com/R1ckNash/Bank/pkg/api/openapi"
+	accountclient "github.com/R1ckNash/Bank/pkg/client/account"
+	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// routers - public router with docs of spec and internal router for other services,
+// their routes are checked against operations of spec in routes_test.go
+func routers(spec *openapi3.T, apiServer api.ServerInterfaceWrapper, jwtSecret, serviceSecret string) (chi.Router, chi.Router) {
+	router := chi.NewRouter()
+
+	router.Use(
+		middleware.Recoverer,
+		middleware.Logger,
+		httpdelivery.PrometheusMiddleware,
+		middleware.Heartbeat("/ping"),
+		middleware.RequestID,
+	)
+
+	openapi.Mount(router, spec)
+
+	router.Group(func(router chi.Router) {
+		router.Use(
+			middleware.URLFormat,
+			auth.AuthMiddleware(jwtSecret),
+		)
+
+		router.Route("/account", func(r chi.Router) {
+			r.Get("/", apiServer.ListAccounts)
+			r.Get("/{accountNumber}", apiServer.GetAccount)
+			r.Get("/{accountNumber}/transactions", apiServer.ListTransactions)
+			r.Get("/{accountNumber}/statement", apiServer.GetStatement)
+			r.Get("/{accountNumber}/holds", apiServer.ListHolds)
+			r.Get("/{accountNumber}/limits", apiServer.GetLimits)
+			r.Post("/create", apiServer.CreateAccount)
+			r.Post("/{accountNumber}/close", apiServer.CloseAccount)
+			r.Post("/transfers", apiServer.Transfer)
+
+			// operators
+			r.Group(func(r chi.Router) {
+				r.Use(auth.RequireRole(auth.RoleOperator))
+				r.Post("/{accountNumber}/block", apiServer.BlockAccount)
+				r.Post("/{accountNumber}/unblock", apiServer.UnblockAccount)
+				r.Post("/{accountNumber}/freeze", apiServer.FreezeAccount)
+				r.Post("/{accountNumber}/unfreeze", apiServer.UnfreezeAccount)
+				r.Post("/holds/{holdID}/release", apiServer.ReleaseHold)
+				r.Put("/{accountNumber}/overdraft", apiServer.SetOverdraft)
+				r.Put("/{accountNumber}/limits", apiServer.SetLimits)
+			})
+		})
+
+		router.Route("/fx", func(r chi.Router) {
+			r.Post("/quotes", apiServer.CreateFXQuote)
+			r.With(auth.RequireRole(auth.RoleOperator)).Post("/rates", apiServer.UploadFXRates)
+		})
+
+		router.Handle("/metrics", promhttp.Handler())
+	})
+
+	// internal delivery, only for other services
+	ir := chi.NewRouter()
+
+	ir.Use(
+		middleware.Recoverer,
+		middleware.Logger,
+		middleware.RequestID,
+		auth.ServiceAuthMiddleware(serviceSecret),
+	)
+
+	ir.Route("/internal", func(r chi.Router) {
+		r.With(auth.RequireScope(accountclient.ScopeAccountsRead)).Get("/account/{accountNumber}", apiServer.InternalGetAccount)
+		r.With(auth.RequireScope(accountclient.ScopeTransfersWrite)).Post("/transfers", apiServer.InternalTransfer)
+
+		r.Group(func(r chi.Router) {
+			r.Use(auth.RequireScope(accountclient.ScopeHoldsWrite))
+			r.Post("/account/{accountNumber}/holds", apiServer.PlaceHold)
+			r.Post("/holds/{holdID}/capture", apiServer.CaptureHold)
+			r.Post("/holds/{holdID}/release", apiServer.InternalReleaseHold)
+		})
+	})
+
+	return router, ir
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"account/api"
+	"testing"
+
+	"github.com/R1ckNash/Bank/pkg/api/openapi"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// spec is loaded from file rather than embedded one, so generated code that is stale after changes of spec fails too
+func loadSpec(t *testing.T) *openapi3.T {
+	spec, err := openapi3.NewLoader().LoadFromFile("../../api/openapi.yaml")
+	require.NoError(t, err)
+	return spec
+}
+
+func TestRoutesMatchSpec(t *testing.T) {
+	spec := loadSpec(t)
+
+	router, ir := routers(spec, api.ServerInterfaceWrapper{}, "secret", "secret")
+
+	require.NoError(t, openapi.CheckRoutes(spec, []chi.Routes{router, ir}, "/metrics"))
+}
+
+func TestQueryParamsMatchSpec(t *testing.T) {
+	spec := loadSpec(t)
+
+	require.NoError(t, openapi.CheckQueryParams(spec, map[string]interface{}{
+		"listAccounts":     api.ListAccountsParams{},
+		"listTransactions": api.ListTransactionsParams{},
+		"getStatement":     api.GetStatementParams{},
+		"listHolds":        api.ListHoldsParams{},
+	}))
+}
@@ -4,24 +4,92 @@ import (
 	"github.com/ilyakaznacheev/cleanenv"
 	"log"
 	"os"
+	"time"
 )
 
 type Config struct {
-	Env       string `yaml:"env" env-default:"local" env-required:"true"`
-	DBUrl     string `yaml:"dbUrl" env-required:"true"`
-	Port      int    `yaml:"port" env-default:"8082"`
-	JWTSecret string `yaml:"jwt-secret" env-default:"supersecretkey"`
+	Env           string        `yaml:"env" env-default:"local" env-required:"true"`
+	DBUrl         string        `yaml:"dbUrl" env-required:"true"`
+	DBReplicaUrls []string      `yaml:"dbReplicaUrls" env:"DB_REPLICA_URLS" env-separator:","`
+	DBSlowQuery   time.Duration `yaml:"dbSlowQuery" env-default:"500ms"`
+	Port          int           `yaml:"port" env-default:"8082"`
+	JWTSecret     string        `yaml:"jwt-secret" env-default:"supersecretkey"`
 
+	// InternalPort - router for other services, protected with service tokens
+	InternalPort int `yaml:"internal_port" env-default:"8092"`
+	ServiceAuth  struct {
+		Secret string `yaml:"secret" env:"SERVICE_JWT_SECRET" env-required:"true"`
+	} `yaml:"service_auth"`
+
+	// AuthService - internal (service-to-service) endpoint of auth service
 	AuthService struct {
-		Host string `yaml:"host" env-default:"bank-auth-service"`
-		Port int    `yaml:"port" env-default:"8080"`
+		Host         string        `yaml:"host" env-default:"bank-auth-service"`
+		Port         int           `yaml:"port" env-default:"8090"`
+		ClientID     string        `yaml:"client_id" env-default:"account-service"`
+		ClientSecret string        `yaml:"client_secret" env:"AUTH_CLIENT_SECRET"`
+		Timeout      time.Duration `yaml:"timeout" env-default:"3s"`
 	} `yaml:"auth_service"`
 
+	// AccountNumber - IBAN-style public account numbers
+	AccountNumber struct {
+		CountryCode string `yaml:"country_code" env-default:"DE"`
+		BankCode    string `yaml:"bank_code" env-required:"true"`
+	} `yaml:"account_number"`
+
+	// Currencies - ISO 4217 codes accepted by this deployment and rounding overrides (half_even by default)
+	Currencies struct {
+		Supported []string          `yaml:"supported" env-default:"EUR,USD"`
+		Rounding  map[string]string `yaml:"rounding"`
+	} `yaml:"currencies"`
+
+	// Products - account products by code, checking is default one; interest_rate is annual
+	Products map[string]Product `yaml:"products"`
+
+	// FX - customer rate is mid rate * (1 - spread), feed file is optional source of rates
+	FX struct {
+		Spread   string        `yaml:"spread" env-default:"0.005"`
+		QuoteTTL time.Duration `yaml:"quote_ttl" env-default:"60s"`
+		Feed     struct {
+			File     string        `yaml:"file"`
+			Interval time.Duration `yaml:"interval" env-default:"1m"`
+			Validity time.Duration `yaml:"validity" env-default:"24h"`
+		} `yaml:"feed"`
+	} `yaml:"fx"`
+
+	// Holds - expiry of reserved funds and sweeper releasing expired holds
+	Holds struct {
+		DefaultTTL    time.Duration `yaml:"default_ttl" env-default:"168h"`
+		MaxTTL        time.Duration `yaml:"max_ttl" env-default:"720h"`
+		SweepInterval time.Duration `yaml:"sweep_interval" env-default:"1m"`
+		SweepBatch    int           `yaml:"sweep_batch" env-default:"100"`
+	} `yaml:"holds"`
+
+	// Interest - worker accruing daily interest and posting it monthly, missed days within lookback are caught up
+	Interest struct {
+		Interval     time.Duration `yaml:"interval" env-default:"1h"`
+		LookbackDays int           `yaml:"lookback_days" env-default:"7"`
+	} `yaml:"interest"`
+
 	Kafka struct {
 		Host string `yaml:"host"`
 	} `yaml:"kafka"`
 }
 
+// Product - limits are by currency of account, product with limits needs them for every supported currency
+// as the same number means different amounts in different currencies; product without limits is unlimited
+type Product struct {
+	Name         string            `yaml:"name"`
+	InterestRate string            `yaml:"interest_rate" env-default:"0"`
+	Limits       map[string]Limits `yaml:"limits"`
+}
+
+// Limits - outgoing limits in account currency, empty means unlimited
+type Limits struct {
+	PerTransaction string `yaml:"per_transaction"`
+	Daily          string `yaml:"daily"`
+	Monthly        string `yaml:"monthly"`
+}
+
 func MustLoad() *Config {
 	configPath := os.Getenv("CONFIG_PATH")
 	if configPath == "" {
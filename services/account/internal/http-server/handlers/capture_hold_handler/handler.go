@@ -0,0 +1,87 @@
+package capture_hold_handler
+
+import (
+	"account/api"
+	"account/internal/http-server/handlers/params"
+	"account/internal/models"
+	slog_helper "account/internal/slog"
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"net/http"
+)
+
+type Path struct {
+	HoldID uuid.UUID `path:"holdID" validate:"required"`
+}
+
+type HoldCapturer interface {
+	CaptureHold(ctx context.Context, id uuid.UUID, amount *decimal.Decimal, capturedBy string) (models.Hold, error)
+}
+
+// Request - amount is optional, whole hold is captured without it
+type Request struct {
+	Amount string `json:"amount" validate:"omitempty,numeric"`
+}
+
+// New - internal endpoint settling hold fully or partially
+func New(log *slog.Logger, capturer HoldCapturer) func(http.ResponseWriter, *http.Request, api.HoldID) {
+	return func(writer http.ResponseWriter, r *http.Request, holdID api.HoldID) {
+		const op = "handlers.hold.capture.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		actor, ok := params.Actor(r)
+		if !ok {
+			problem.Write(writer, r, problem.Unauthorized.New(""))
+			return
+		}
+
+		path := Path{HoldID: holdID}
+		if err := validation.Params(&path); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+		id := path.HoldID
+
+		var req Request
+		if err := validation.DecodeJSON(writer, r, &req, validation.AllowEmptyBody()); err != nil {
+			log.Error("Failed to decode body", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		var amount *decimal.Decimal
+		if req.Amount != "" {
+			value, err := decimal.NewFromString(req.Amount)
+			if err != nil {
+				problem.Write(writer, r, problem.BadRequest.New("invalid amount"))
+				return
+			}
+			amount = &value
+		}
+
+		log.Info("Received request for capture hold", slog.String("hold_id", id.String()), slog.String("actor", actor))
+
+		hold, err := capturer.CaptureHold(r.Context(), id, amount, actor)
+		if err != nil {
+			log.Error("failed to capture hold", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.JSON(writer, r, resp.OKWithData(map[string]interface{}{
+			"status": resp.StatusOK,
+			"hold":   hold,
+		}))
+	}
+}
@@ -0,0 +1,68 @@
+package close_handler
+
+import (
+	"account/api"
+	slog_helper "account/internal/slog"
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	"github.com/R1ckNash/Bank/pkg/iban"
+	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+)
+
+type Path struct {
+	AccountNumber string `path:"accountNumber" validate:"required,iban"`
+}
+
+type AccountCloser interface {
+	CloseAccount(ctx context.Context, number string, ownerID uuid.UUID) error
+}
+
+// New - owner closes own account, balance must be zero
+func New(log *slog.Logger, accountCloser AccountCloser) func(http.ResponseWriter, *http.Request, api.AccountNumber) {
+	return func(writer http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber) {
+		const op = "handlers.account.close.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		userID, ok := auth.GetUserID(r)
+		if !ok {
+			problem.Write(writer, r, problem.Unauthorized.New(""))
+			return
+		}
+
+		ownerID, err := uuid.Parse(userID)
+		if err != nil {
+			log.Error("Failed to parse user id")
+			problem.Write(writer, r, problem.BadRequest.New("failed to parse user id"))
+			return
+		}
+
+		path := Path{AccountNumber: accountNumber}
+		if err := validation.Params(&path); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+		number := iban.Normalize(path.AccountNumber)
+
+		log.Info("Received request for account closure", slog.String("number", number))
+
+		err = accountCloser.CloseAccount(r.Context(), number, ownerID)
+		if err != nil {
+			log.Error("failed to close account", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.JSON(writer, r, resp.OK())
+	}
+}
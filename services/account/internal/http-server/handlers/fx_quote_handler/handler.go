@@ -0,0 +1,65 @@
+package fx_quote_handler
+
+import (
+	"account/internal/models"
+	slog_helper "account/internal/slog"
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"net/http"
+)
+
+type Quoter interface {
+	Quote(ctx context.Context, from, to string, amount decimal.Decimal) (models.FXQuote, error)
+}
+
+type Request struct {
+	From   string `json:"from" validate:"required,len=3,alpha"`
+	To     string `json:"to" validate:"required,len=3,alpha"`
+	Amount string `json:"amount" validate:"omitempty,numeric"`
+}
+
+// New - locks customer rate, returned quote id can be passed to transfer until it expires
+func New(log *slog.Logger, quoter Quoter) http.HandlerFunc {
+	return func(writer http.ResponseWriter, r *http.Request) {
+		const op = "handlers.fx.quote.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		var req Request
+		if err := validation.DecodeJSON(writer, r, &req); err != nil {
+			log.Error("Failed to decode body", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		amount := decimal.Zero
+		if req.Amount != "" {
+			var err error
+			if amount, err = decimal.NewFromString(req.Amount); err != nil || amount.IsNegative() {
+				problem.Write(writer, r, problem.BadRequest.New("invalid amount"))
+				return
+			}
+		}
+
+		quote, err := quoter.Quote(r.Context(), req.From, req.To, amount)
+		if err != nil {
+			log.Error("failed to quote fx rate", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.JSON(writer, r, resp.OKWithData(map[string]interface{}{
+			"status": resp.StatusOK,
+			"quote":  quote,
+		}))
+	}
+}
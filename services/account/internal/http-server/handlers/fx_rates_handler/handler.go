@@ -0,0 +1,74 @@
+package fx_rates_handler
+
+import (
+	"account/internal/models"
+	slog_helper "account/internal/slog"
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+type RatesUploader interface {
+	UploadRates(ctx context.Context, snapshot *models.FXRateSnapshot) error
+}
+
+type Request struct {
+	ValidFrom time.Time       `json:"valid_from"`
+	ValidTo   time.Time       `json:"valid_to" validate:"required"`
+	Rates     []models.FXRate `json:"rates" validate:"required,min=1,dive"`
+}
+
+// New - operator endpoint uploading snapshot of mid-market rates
+func New(log *slog.Logger, uploader RatesUploader) http.HandlerFunc {
+	return func(writer http.ResponseWriter, r *http.Request) {
+		const op = "handlers.fx.rates.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		operatorID, ok := auth.GetUserID(r)
+		if !ok {
+			problem.Write(writer, r, problem.Unauthorized.New(""))
+			return
+		}
+
+		var req Request
+		if err := validation.DecodeJSON(writer, r, &req); err != nil {
+			log.Error("Failed to decode body", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		if req.ValidFrom.IsZero() {
+			req.ValidFrom = time.Now()
+		}
+
+		snapshot := &models.FXRateSnapshot{
+			Source:    models.FXSourceAdminUpload,
+			ValidFrom: req.ValidFrom,
+			ValidTo:   req.ValidTo,
+			CreatedBy: operatorID,
+			Rates:     req.Rates,
+		}
+
+		if err := uploader.UploadRates(r.Context(), snapshot); err != nil {
+			log.Error("failed to upload fx rates", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.JSON(writer, r, resp.OKWithData(map[string]interface{}{
+			"status":   resp.StatusOK,
+			"snapshot": snapshot,
+		}))
+	}
+}
@@ -1,43 +1,57 @@
 package get_handler
 
 import (
+	"account/api"
+	"account/internal/http-server/handlers/params"
 	"account/internal/models"
-	slog_helper "account/internal/slog"
 	"context"
-	"github.com/go-chi/chi/v5"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	"github.com/R1ckNash/Bank/pkg/iban"
+	"github.com/R1ckNash/Bank/pkg/validation"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/render"
 	"log/slog"
 	"net/http"
-	"strconv"
 )
 
+type Path struct {
+	AccountNumber string `path:"accountNumber" validate:"required,iban"`
+}
+
 type AccountGetter interface {
-	GetAccount(ctx context.Context, accountID int64) (models.Account, error)
+	GetAccount(ctx context.Context, number string) (models.Account, error)
 }
 
-func New(log *slog.Logger, accountGetter AccountGetter) http.HandlerFunc {
-	return func(writer http.ResponseWriter, r *http.Request) {
+// New - account by number, visible to its owner and operators
+func New(log *slog.Logger, accountGetter AccountGetter) func(http.ResponseWriter, *http.Request, api.AccountNumber) {
+	return func(writer http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber) {
 		const op = "handlers.account.get.New"
 
-		log = log.With(
+		log := log.With(
 			slog.String("op", op),
 			slog.String("request_id", middleware.GetReqID(r.Context())),
 		)
 
-		var requestParam = chi.URLParam(r, "accountId")
-		id, err := strconv.Atoi(requestParam)
-		if err != nil {
-			log.Error("failed to decode id", slog_helper.Err(err))
-			http.Error(writer, `{"error": "incorrect accountId"}`, http.StatusBadRequest)
+		path := Path{AccountNumber: accountNumber}
+		if err := validation.Params(&path); err != nil {
+			problem.WriteError(writer, r, err)
+			return
 		}
+		number := iban.Normalize(path.AccountNumber)
 
-		log.Info("Received request for get account", slog.Int("accountId", id))
+		log.Info("Received request for get account", slog.String("number", number))
 
-		account, err := accountGetter.GetAccount(r.Context(), int64(id))
+		account, err := accountGetter.GetAccount(r.Context(), number)
 		if err != nil {
-			log.Error("failed to retrieve account", slog.Int("accountId", id))
-			http.Error(writer, `{"error": "internal server error"}`, http.StatusInternalServerError)
+			log.Error("failed to retrieve account", slog.String("number", number))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		// don't reveal existence of other users' accounts
+		if !params.CanAccess(r, account.OwnerID) {
+			problem.Write(writer, r, problem.AccountNotFound.New("account not found"))
+			return
 		}
 
 		render.JSON(writer, r, account)
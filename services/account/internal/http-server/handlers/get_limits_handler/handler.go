@@ -0,0 +1,65 @@
+package get_limits_handler
+
+import (
+	"account/api"
+	"account/internal/http-server/handlers/params"
+	"account/internal/models"
+	slog_helper "account/internal/slog"
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	"github.com/R1ckNash/Bank/pkg/iban"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"log/slog"
+	"net/http"
+)
+
+type Path struct {
+	AccountNumber string `path:"accountNumber" validate:"required,iban"`
+}
+
+type LimitsGetter interface {
+	GetAccount(ctx context.Context, number string) (models.Account, error)
+	GetLimits(ctx context.Context, number string) (models.AccountLimits, error)
+}
+
+// New - effective outgoing limits of account and their usage, visible to its owner and operators
+func New(log *slog.Logger, getter LimitsGetter) func(http.ResponseWriter, *http.Request, api.AccountNumber) {
+	return func(writer http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber) {
+		const op = "handlers.account.limits.get.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		path := Path{AccountNumber: accountNumber}
+		if err := validation.Params(&path); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+		number := iban.Normalize(path.AccountNumber)
+
+		account, err := getter.GetAccount(r.Context(), number)
+		if err != nil {
+			log.Error("failed to retrieve account", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		if !params.CanAccess(r, account.OwnerID) {
+			problem.Write(writer, r, problem.AccountNotFound.New("account not found"))
+			return
+		}
+
+		limits, err := getter.GetLimits(r.Context(), number)
+		if err != nil {
+			log.Error("failed to retrieve limits", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.JSON(writer, r, limits)
+	}
+}
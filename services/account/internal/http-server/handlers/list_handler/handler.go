@@ -0,0 +1,86 @@
+package list_handler
+
+import (
+	"account/api"
+	"account/internal/http-server/handlers/params"
+	"account/internal/models"
+	slog_helper "account/internal/slog"
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+)
+
+type AccountLister interface {
+	ListAccounts(ctx context.Context, ownerID uuid.UUID, filter models.AccountFilter) ([]models.Account, int, error)
+}
+
+type Response struct {
+	Accounts []models.Account `json:"accounts"`
+	Total    int              `json:"total"`
+	Limit    int              `json:"limit"`
+	Offset   int              `json:"offset"`
+}
+
+// New - accounts of authenticated user, query params: currency, status, limit, offset
+func New(log *slog.Logger, accountLister AccountLister) func(http.ResponseWriter, *http.Request, api.ListAccountsParams) {
+	return func(writer http.ResponseWriter, r *http.Request, query api.ListAccountsParams) {
+		const op = "handlers.account.list.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		userID, ok := auth.GetUserID(r)
+		if !ok {
+			problem.Write(writer, r, problem.Unauthorized.New(""))
+			return
+		}
+
+		ownerID, err := uuid.Parse(userID)
+		if err != nil {
+			log.Error("Failed to parse user id")
+			problem.Write(writer, r, problem.BadRequest.New("failed to parse user id"))
+			return
+		}
+
+		if err = validation.Params(&query); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		filter := models.AccountFilter{
+			Currency: params.Value(query.Currency, ""),
+			Status:   models.AccountStatus(params.Value(query.Status, "")),
+			Limit:    params.Value(query.Limit, models.ListLimitDefault),
+			Offset:   params.Value(query.Offset, 0),
+		}
+
+		if filter.Status != "" && !filter.Status.IsValid() {
+			problem.Write(writer, r, problem.BadRequest.New("incorrect status"))
+			return
+		}
+
+		log.Info("Received request for list accounts")
+
+		accounts, total, err := accountLister.ListAccounts(r.Context(), ownerID, filter)
+		if err != nil {
+			log.Error("failed to list accounts", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.JSON(writer, r, Response{
+			Accounts: accounts,
+			Total:    total,
+			Limit:    filter.Limit,
+			Offset:   filter.Offset,
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package list_holds_handler
+
+import (
+	"account/api"
+	"account/internal/http-server/handlers/params"
+	"account/internal/models"
+	slog_helper "account/internal/slog"
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	"github.com/R1ckNash/Bank/pkg/iban"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"log/slog"
+	"net/http"
+)
+
+type Path struct {
+	AccountNumber string `path:"accountNumber" validate:"required,iban"`
+}
+
+type HoldLister interface {
+	GetAccount(ctx context.Context, number string) (models.Account, error)
+	ListHolds(ctx context.Context, number string, status models.HoldStatus) ([]models.Hold, error)
+}
+
+type Response struct {
+	Holds []models.Hold `json:"holds"`
+}
+
+// New - holds of account, query param status filters them
+func New(log *slog.Logger, lister HoldLister) func(http.ResponseWriter, *http.Request, api.AccountNumber, api.ListHoldsParams) {
+	return func(writer http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber, query api.ListHoldsParams) {
+		const op = "handlers.hold.list.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		path := Path{AccountNumber: accountNumber}
+		if err := validation.Params(&path); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+		number := iban.Normalize(path.AccountNumber)
+
+		if err := validation.Params(&query); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+		status := models.HoldStatus(params.Value(query.Status, ""))
+
+		account, err := lister.GetAccount(r.Context(), number)
+		if err != nil {
+			log.Error("failed to retrieve account", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		if !params.CanAccess(r, account.OwnerID) {
+			problem.Write(writer, r, problem.AccountNotFound.New("account not found"))
+			return
+		}
+
+		holds, err := lister.ListHolds(r.Context(), number, status)
+		if err != nil {
+			log.Error("failed to list holds", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.JSON(writer, r, Response{Holds: holds})
+	}
+}
@@ -0,0 +1,93 @@
+package overdraft_handler
+
+import (
+	"account/api"
+	"account/internal/models"
+	slog_helper "account/internal/slog"
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	"github.com/R1ckNash/Bank/pkg/iban"
+	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"net/http"
+)
+
+type Path struct {
+	AccountNumber string `path:"accountNumber" validate:"required,iban"`
+}
+
+type OverdraftSetter interface {
+	SetOverdraft(ctx context.Context, number string, settings models.OverdraftSettings) (models.Account, error)
+}
+
+// Request - limit in account currency, rate is annual, e.g. "0.15"
+type Request struct {
+	Limit string `json:"limit" validate:"required,numeric"`
+	Rate  string `json:"rate" validate:"required,numeric"`
+}
+
+// New - operator endpoint approving overdraft for account
+func New(log *slog.Logger, setter OverdraftSetter) func(http.ResponseWriter, *http.Request, api.AccountNumber) {
+	return func(writer http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber) {
+		const op = "handlers.account.overdraft.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		operatorID, ok := auth.GetUserID(r)
+		if !ok {
+			problem.Write(writer, r, problem.Unauthorized.New(""))
+			return
+		}
+
+		path := Path{AccountNumber: accountNumber}
+		if err := validation.Params(&path); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+		number := iban.Normalize(path.AccountNumber)
+
+		var req Request
+		if err := validation.DecodeJSON(writer, r, &req); err != nil {
+			log.Error("Failed to decode body", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		limit, err := decimal.NewFromString(req.Limit)
+		if err != nil {
+			problem.Write(writer, r, problem.BadRequest.New("invalid limit"))
+			return
+		}
+		rate, err := decimal.NewFromString(req.Rate)
+		if err != nil {
+			problem.Write(writer, r, problem.BadRequest.New("invalid rate"))
+			return
+		}
+
+		log.Info("Received request for set overdraft", slog.String("number", number), slog.String("operator_id", operatorID))
+
+		account, err := setter.SetOverdraft(r.Context(), number, models.OverdraftSettings{
+			Limit:     limit,
+			Rate:      rate,
+			ChangedBy: operatorID,
+		})
+		if err != nil {
+			log.Error("failed to set overdraft", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.JSON(writer, r, resp.OKWithData(map[string]interface{}{
+			"status":  resp.StatusOK,
+			"account": account,
+		}))
+	}
+}
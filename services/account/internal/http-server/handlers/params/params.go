@@ -0,0 +1,36 @@
+package params
+
+import (
+	"net/http"
+
+	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/google/uuid"
+)
+
+// CanAccess - account data is visible to its owner, operators and services (internal router checks scopes)
+func CanAccess(r *http.Request, ownerID uuid.UUID) bool {
+	if _, ok := auth.GetServiceID(r); ok {
+		return true
+	}
+
+	userID, _ := auth.GetUserID(r)
+	role, _ := auth.GetRole(r)
+
+	return ownerID.String() == userID || role == auth.RoleOperator
+}
+
+// Value - optional param bound by generated server wrapper, def when it's absent
+func Value[T any](param *T, def T) T {
+	if param == nil {
+		return def
+	}
+	return *param
+}
+
+// Actor - id of authenticated user or service making request
+func Actor(r *http.Request) (string, bool) {
+	if serviceID, ok := auth.GetServiceID(r); ok {
+		return serviceID, true
+	}
+	return auth.GetUserID(r)
+}
@@ -0,0 +1,95 @@
+package place_hold_handler
+
+import (
+	"account/api"
+	"account/internal/http-server/handlers/params"
+	"account/internal/models"
+	slog_helper "account/internal/slog"
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	"github.com/R1ckNash/Bank/pkg/iban"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+type Path struct {
+	AccountNumber string `path:"accountNumber" validate:"required,iban"`
+}
+
+type HoldPlacer interface {
+	PlaceHold(ctx context.Context, req models.PlaceHoldRequest) (models.Hold, error)
+}
+
+type Request struct {
+	Amount      string    `json:"amount" validate:"required,numeric"`
+	Reference   string    `json:"reference" validate:"max=128"`
+	Description string    `json:"description" validate:"max=256"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// New - internal endpoint reserving funds on account, e.g. card authorization
+func New(log *slog.Logger, placer HoldPlacer) func(http.ResponseWriter, *http.Request, api.AccountNumber, *api.IdempotencyKey) {
+	return func(writer http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber, idempotencyKey *api.IdempotencyKey) {
+		const op = "handlers.hold.place.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		actor, ok := params.Actor(r)
+		if !ok {
+			problem.Write(writer, r, problem.Unauthorized.New(""))
+			return
+		}
+
+		path := Path{AccountNumber: accountNumber}
+		if err := validation.Params(&path); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+		number := iban.Normalize(path.AccountNumber)
+
+		var req Request
+		if err := validation.DecodeJSON(writer, r, &req); err != nil {
+			log.Error("Failed to decode body", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		amount, err := decimal.NewFromString(req.Amount)
+		if err != nil {
+			problem.Write(writer, r, problem.BadRequest.New("invalid amount"))
+			return
+		}
+
+		log.Info("Received request for place hold", slog.String("number", number), slog.String("actor", actor))
+
+		hold, err := placer.PlaceHold(r.Context(), models.PlaceHoldRequest{
+			Number:         number,
+			Amount:         amount,
+			Reference:      req.Reference,
+			Description:    req.Description,
+			ExpiresAt:      req.ExpiresAt,
+			IdempotencyKey: params.Value(idempotencyKey, ""),
+			CreatedBy:      actor,
+		})
+		if err != nil {
+			log.Error("failed to place hold", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.Status(r, http.StatusCreated)
+		render.JSON(writer, r, resp.OKWithData(map[string]interface{}{
+			"status": resp.StatusOK,
+			"hold":   hold,
+		}))
+	}
+}
@@ -1,13 +1,15 @@
 package post_handler
 
 import (
-	"account/internal/config"
 	"account/internal/models"
 	slog_helper "account/internal/slog"
 	"context"
-	"fmt"
+	"errors"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
 	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	authclient "github.com/R1ckNash/Bank/pkg/client/auth"
 	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/R1ckNash/Bank/pkg/validation"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/render"
 	"github.com/google/uuid"
@@ -19,14 +21,19 @@ type AccountCreator interface {
 	RegisterAccount(ctx context.Context, account *models.Account) error
 }
 
+type UserVerifier interface {
+	Verify(ctx context.Context, userID uuid.UUID) error
+}
+
 type Request struct {
-	OwnerID  string `json:"owner_id" validate:"required"`
-	Name     string `json:"name" validate:"required"`
-	Currency string `json:"currency"`
-	Email    string `json:"email"`
+	OwnerID  string `json:"owner_id" validate:"omitempty,uuid"`
+	Name     string `json:"name" validate:"required,max=128"`
+	Currency string `json:"currency" validate:"required,iso4217"`
+	Product  string `json:"product"`
+	Email    string `json:"email" validate:"omitempty,email"`
 }
 
-func New(log *slog.Logger, accountCreator AccountCreator, cfg *config.Config) http.HandlerFunc {
+func New(log *slog.Logger, accountCreator AccountCreator, userVerifier UserVerifier) http.HandlerFunc {
 	return func(writer http.ResponseWriter, r *http.Request) {
 		const op = "handlers.account.post.New"
 
@@ -40,16 +47,7 @@ func New(log *slog.Logger, accountCreator AccountCreator, cfg *config.Config) ht
 		// get user id from context
 		userID, ok := auth.GetUserID(r)
 		if !ok {
-			http.Error(writer, "Unauthorized", http.StatusUnauthorized)
-			return
-		}
-
-		// verify user id
-		verifyURL := fmt.Sprintf("http://%s:%d/user/verify/%s", cfg.AuthService.Host, cfg.AuthService.Port, userID)
-
-		authResp, err := http.Get(verifyURL)
-		if err != nil || authResp.StatusCode != 200 {
-			http.Error(writer, "User not found in auth service", http.StatusForbidden)
+			problem.Write(writer, r, problem.Unauthorized.New(""))
 			return
 		}
 
@@ -58,32 +56,50 @@ func New(log *slog.Logger, accountCreator AccountCreator, cfg *config.Config) ht
 		id, err := uuid.Parse(userID)
 		if err != nil {
 			log.Error("Failed to parse user id")
-			http.Error(writer, `{"error": "failed to parse user id"}`, http.StatusBadRequest)
+			problem.Write(writer, r, problem.BadRequest.New("failed to parse user id"))
+			return
 		}
 
-		err = render.DecodeJSON(r.Body, &req)
+		// verify user id
+		if err := userVerifier.Verify(r.Context(), id); err != nil {
+			if errors.Is(err, authclient.ErrUserNotFound) {
+				problem.Write(writer, r, problem.Forbidden.New("user not found in auth service"))
+				return
+			}
+			log.Error("failed to verify user", slog_helper.Err(err))
+			problem.Write(writer, r, problem.Unavailable.New("auth service unavailable"))
+			return
+		}
+
+		err = validation.DecodeJSON(writer, r, &req)
 		if err != nil {
-			log.Error("Failed to decode body")
-			http.Error(writer, `{"error": "failed to decode body"}`, http.StatusBadRequest)
+			log.Error("Failed to decode body", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
 		}
 
 		account := &models.Account{
 			OwnerID:  id,
 			Name:     req.Name,
 			Currency: req.Currency,
+			Product:  req.Product,
 			Email:    req.Email,
 		}
 
 		err = accountCreator.RegisterAccount(r.Context(), account)
 		if err != nil {
 			log.Error("failed to create account", slog_helper.Err(err))
-			http.Error(writer, `{"error": "internal server error"}`, http.StatusInternalServerError)
+			problem.WriteError(writer, r, err)
+			return
 		}
 
-		responseOK(writer, r)
+		responseOK(writer, r, account)
 	}
 }
 
-func responseOK(w http.ResponseWriter, r *http.Request) {
-	render.JSON(w, r, resp.OK())
+func responseOK(w http.ResponseWriter, r *http.Request, account *models.Account) {
+	render.JSON(w, r, resp.OKWithData(map[string]interface{}{
+		"status":  resp.StatusOK,
+		"account": account,
+	}))
 }
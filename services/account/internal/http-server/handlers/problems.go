@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"account/internal/models"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+)
+
+// RegisterProblems - domain errors are written by handlers as these problems (problem.WriteError),
+// specific limit errors go before ErrLimitExceeded they wrap
+func RegisterProblems() {
+	problem.Map(models.ErrNotFound, problem.AccountNotFound)
+	problem.Map(models.ErrAlreadyExists, problem.AlreadyExists)
+	problem.Map(models.ErrIdempotencyMismatch, problem.IdempotencyMismatch)
+	problem.Map(models.ErrForbidden, problem.Forbidden)
+	problem.Map(models.ErrAccountNotActive, problem.AccountNotActive)
+	problem.Map(models.ErrInsufficientFunds, problem.InsufficientFunds)
+	problem.Map(models.ErrTransactionLimitExceeded, problem.TransactionLimitExceeded)
+	problem.Map(models.ErrDailyLimitExceeded, problem.DailyLimitExceeded)
+	problem.Map(models.ErrMonthlyLimitExceeded, problem.MonthlyLimitExceeded)
+	problem.Map(models.ErrLimitExceeded, problem.LimitExceeded)
+
+	for _, err := range []error{
+		models.ErrInvalidAmount, models.ErrSameAccount, models.ErrUnsupportedCurrency, models.ErrUnknownProduct,
+		models.ErrInvalidReasonCode, models.ErrInvalidCursor, models.ErrInvalidExpiry, models.ErrInvalidOverdraft,
+		models.ErrInvalidLimits, models.ErrInvalidRates, models.ErrQuoteMismatch,
+	} {
+		problem.Map(err, problem.BadRequest)
+	}
+
+	for _, err := range []error{models.ErrHoldNotFound, models.ErrRateNotFound, models.ErrQuoteNotFound} {
+		problem.Map(err, problem.NotFound)
+	}
+
+	for _, err := range []error{
+		models.ErrInvalidTransition, models.ErrNonZeroBalance, models.ErrHoldNotActive, models.ErrQuoteExpired,
+	} {
+		problem.Map(err, problem.Conflict)
+	}
+}
@@ -0,0 +1,64 @@
+package release_hold_handler
+
+import (
+	"account/api"
+	"account/internal/http-server/handlers/params"
+	"account/internal/models"
+	slog_helper "account/internal/slog"
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+)
+
+type Path struct {
+	HoldID uuid.UUID `path:"holdID" validate:"required"`
+}
+
+type HoldReleaser interface {
+	ReleaseHold(ctx context.Context, id uuid.UUID, releasedBy string) (models.Hold, error)
+}
+
+// New - cancels active hold, used by services and operators
+func New(log *slog.Logger, releaser HoldReleaser) func(http.ResponseWriter, *http.Request, api.HoldID) {
+	return func(writer http.ResponseWriter, r *http.Request, holdID api.HoldID) {
+		const op = "handlers.hold.release.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		actor, ok := params.Actor(r)
+		if !ok {
+			problem.Write(writer, r, problem.Unauthorized.New(""))
+			return
+		}
+
+		path := Path{HoldID: holdID}
+		if err := validation.Params(&path); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+		id := path.HoldID
+
+		log.Info("Received request for release hold", slog.String("hold_id", id.String()), slog.String("actor", actor))
+
+		hold, err := releaser.ReleaseHold(r.Context(), id, actor)
+		if err != nil {
+			log.Error("failed to release hold", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.JSON(writer, r, resp.OKWithData(map[string]interface{}{
+			"status": resp.StatusOK,
+			"hold":   hold,
+		}))
+	}
+}
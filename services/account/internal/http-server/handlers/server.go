@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"account/api"
+	"account/internal/http-server/handlers/capture_hold_handler"
+	"account/internal/http-server/handlers/close_handler"
+	"account/internal/http-server/handlers/fx_quote_handler"
+	"account/internal/http-server/handlers/fx_rates_handler"
+	"account/internal/http-server/handlers/get_handler"
+	"account/internal/http-server/handlers/get_limits_handler"
+	"account/internal/http-server/handlers/list_handler"
+	"account/internal/http-server/handlers/list_holds_handler"
+	"account/internal/http-server/handlers/overdraft_handler"
+	"account/internal/http-server/handlers/place_hold_handler"
+	"account/internal/http-server/handlers/post_handler"
+	"account/internal/http-server/handlers/release_hold_handler"
+	"account/internal/http-server/handlers/set_limits_handler"
+	"account/internal/http-server/handlers/statement_handler"
+	"account/internal/http-server/handlers/status_handler"
+	"account/internal/http-server/handlers/transactions_handler"
+	"account/internal/http-server/handlers/transfer_handler"
+	"account/internal/models"
+	"log/slog"
+	"net/http"
+)
+
+// AccountService - what handlers need from account service
+type AccountService interface {
+	list_handler.AccountLister
+	get_handler.AccountGetter
+	transactions_handler.TransactionLister
+	statement_handler.StatementGetter
+	list_holds_handler.HoldLister
+	get_limits_handler.LimitsGetter
+	set_limits_handler.LimitsSetter
+	post_handler.AccountCreator
+	close_handler.AccountCloser
+	transfer_handler.Transferer
+	status_handler.StatusChanger
+	overdraft_handler.OverdraftSetter
+	place_hold_handler.HoldPlacer
+	capture_hold_handler.HoldCapturer
+	release_hold_handler.HoldReleaser
+}
+
+// FXService - what handlers need from fx service
+type FXService interface {
+	fx_quote_handler.Quoter
+	fx_rates_handler.RatesUploader
+}
+
+// Server - implements api.ServerInterface generated from api/openapi.yaml with handlers of service.
+// Params are bound by generated wrapper and passed to handlers, which validate them by validate tags
+type Server struct {
+	list                                    func(http.ResponseWriter, *http.Request, api.ListAccountsParams)
+	create, fxQuote, fxRates                http.HandlerFunc
+	transfer                                func(http.ResponseWriter, *http.Request, *api.IdempotencyKey)
+	get, getLimits, setLimits, closeAccount func(http.ResponseWriter, *http.Request, api.AccountNumber)
+	block, unblock, freeze, unfreeze        func(http.ResponseWriter, *http.Request, api.AccountNumber)
+	overdraft                               func(http.ResponseWriter, *http.Request, api.AccountNumber)
+	transactions                            func(http.ResponseWriter, *http.Request, api.AccountNumber, api.ListTransactionsParams)
+	statement                               func(http.ResponseWriter, *http.Request, api.AccountNumber, api.GetStatementParams)
+	listHolds                               func(http.ResponseWriter, *http.Request, api.AccountNumber, api.ListHoldsParams)
+	placeHold                               func(http.ResponseWriter, *http.Request, api.AccountNumber, *api.IdempotencyKey)
+	captureHold, releaseHold                func(http.ResponseWriter, *http.Request, api.HoldID)
+}
+
+var _ api.ServerInterface = (*Server)(nil)
+
+func NewServer(log *slog.Logger, accounts AccountService, fx FXService, userVerifier post_handler.UserVerifier) *Server {
+	return &Server{
+		list:         list_handler.New(log, accounts),
+		get:          get_handler.New(log, accounts),
+		transactions: transactions_handler.New(log, accounts),
+		statement:    statement_handler.New(log, accounts),
+		listHolds:    list_holds_handler.New(log, accounts),
+		getLimits:    get_limits_handler.New(log, accounts),
+		setLimits:    set_limits_handler.New(log, accounts),
+		create:       post_handler.New(log, accounts, userVerifier),
+		closeAccount: close_handler.New(log, accounts),
+		transfer:     transfer_handler.New(log, accounts),
+		overdraft:    overdraft_handler.New(log, accounts),
+		block:        status_handler.New(log, accounts, models.ActionBlock),
+		unblock:      status_handler.New(log, accounts, models.ActionUnblock),
+		freeze:       status_handler.New(log, accounts, models.ActionFreeze),
+		unfreeze:     status_handler.New(log, accounts, models.ActionUnfreeze),
+		placeHold:    place_hold_handler.New(log, accounts),
+		captureHold:  capture_hold_handler.New(log, accounts),
+		releaseHold:  release_hold_handler.New(log, accounts),
+		fxQuote:      fx_quote_handler.New(log, fx),
+		fxRates:      fx_rates_handler.New(log, fx),
+	}
+}
+
+func (s *Server) ListAccounts(w http.ResponseWriter, r *http.Request, params api.ListAccountsParams) {
+	s.list(w, r, params)
+}
+
+func (s *Server) CreateAccount(w http.ResponseWriter, r *http.Request) {
+	s.create(w, r)
+}
+
+func (s *Server) Transfer(w http.ResponseWriter, r *http.Request, params api.TransferParams) {
+	s.transfer(w, r, params.IdempotencyKey)
+}
+
+func (s *Server) GetAccount(w http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber) {
+	s.get(w, r, accountNumber)
+}
+
+func (s *Server) ListTransactions(w http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber, params api.ListTransactionsParams) {
+	s.transactions(w, r, accountNumber, params)
+}
+
+func (s *Server) GetStatement(w http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber, params api.GetStatementParams) {
+	s.statement(w, r, accountNumber, params)
+}
+
+func (s *Server) ListHolds(w http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber, params api.ListHoldsParams) {
+	s.listHolds(w, r, accountNumber, params)
+}
+
+func (s *Server) GetLimits(w http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber) {
+	s.getLimits(w, r, accountNumber)
+}
+
+func (s *Server) SetLimits(w http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber) {
+	s.setLimits(w, r, accountNumber)
+}
+
+func (s *Server) CloseAccount(w http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber) {
+	s.closeAccount(w, r, accountNumber)
+}
+
+func (s *Server) BlockAccount(w http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber) {
+	s.block(w, r, accountNumber)
+}
+
+func (s *Server) UnblockAccount(w http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber) {
+	s.unblock(w, r, accountNumber)
+}
+
+func (s *Server) FreezeAccount(w http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber) {
+	s.freeze(w, r, accountNumber)
+}
+
+func (s *Server) UnfreezeAccount(w http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber) {
+	s.unfreeze(w, r, accountNumber)
+}
+
+func (s *Server) SetOverdraft(w http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber) {
+	s.overdraft(w, r, accountNumber)
+}
+
+func (s *Server) ReleaseHold(w http.ResponseWriter, r *http.Request, holdID api.HoldID) {
+	s.releaseHold(w, r, holdID)
+}
+
+func (s *Server) CreateFXQuote(w http.ResponseWriter, r *http.Request) {
+	s.fxQuote(w, r)
+}
+
+func (s *Server) UploadFXRates(w http.ResponseWriter, r *http.Request) {
+	s.fxRates(w, r)
+}
+
+func (s *Server) InternalGetAccount(w http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber) {
+	s.get(w, r, accountNumber)
+}
+
+func (s *Server) InternalTransfer(w http.ResponseWriter, r *http.Request, params api.InternalTransferParams) {
+	s.transfer(w, r, params.IdempotencyKey)
+}
+
+func (s *Server) PlaceHold(w http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber, params api.PlaceHoldParams) {
+	s.placeHold(w, r, accountNumber, params.IdempotencyKey)
+}
+
+func (s *Server) CaptureHold(w http.ResponseWriter, r *http.Request, holdID api.HoldID) {
+	s.captureHold(w, r, holdID)
+}
+
+func (s *Server) InternalReleaseHold(w http.ResponseWriter, r *http.Request, holdID api.HoldID) {
+	s.releaseHold(w, r, holdID)
+}
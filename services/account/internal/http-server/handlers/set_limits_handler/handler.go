@@ -0,0 +1,107 @@
+package set_limits_handler
+
+import (
+	"account/api"
+	"account/internal/models"
+	slog_helper "account/internal/slog"
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	"github.com/R1ckNash/Bank/pkg/iban"
+	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"net/http"
+)
+
+type Path struct {
+	AccountNumber string `path:"accountNumber" validate:"required,iban"`
+}
+
+type LimitsSetter interface {
+	SetLimits(ctx context.Context, number string, settings models.LimitsSettings) (models.AccountLimits, error)
+}
+
+// Request - limits in account currency, omitted limit falls back to limit of product
+type Request struct {
+	PerTransaction *string `json:"per_transaction" validate:"omitempty,numeric"`
+	Daily          *string `json:"daily" validate:"omitempty,numeric"`
+	Monthly        *string `json:"monthly" validate:"omitempty,numeric"`
+}
+
+// New - operator endpoint overriding outgoing limits of account
+func New(log *slog.Logger, setter LimitsSetter) func(http.ResponseWriter, *http.Request, api.AccountNumber) {
+	return func(writer http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber) {
+		const op = "handlers.account.limits.set.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		operatorID, ok := auth.GetUserID(r)
+		if !ok {
+			problem.Write(writer, r, problem.Unauthorized.New(""))
+			return
+		}
+
+		path := Path{AccountNumber: accountNumber}
+		if err := validation.Params(&path); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+		number := iban.Normalize(path.AccountNumber)
+
+		var req Request
+		if err := validation.DecodeJSON(writer, r, &req); err != nil {
+			log.Error("Failed to decode body", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		settings := models.LimitsSettings{ChangedBy: operatorID}
+		var err error
+		if settings.PerTransaction, err = parseLimit(req.PerTransaction); err != nil {
+			problem.Write(writer, r, problem.BadRequest.New("invalid per_transaction limit"))
+			return
+		}
+		if settings.Daily, err = parseLimit(req.Daily); err != nil {
+			problem.Write(writer, r, problem.BadRequest.New("invalid daily limit"))
+			return
+		}
+		if settings.Monthly, err = parseLimit(req.Monthly); err != nil {
+			problem.Write(writer, r, problem.BadRequest.New("invalid monthly limit"))
+			return
+		}
+
+		log.Info("Received request for set limits", slog.String("number", number), slog.String("operator_id", operatorID))
+
+		limits, err := setter.SetLimits(r.Context(), number, settings)
+		if err != nil {
+			log.Error("failed to set limits", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.JSON(writer, r, resp.OKWithData(map[string]interface{}{
+			"status": resp.StatusOK,
+			"limits": limits,
+		}))
+	}
+}
+
+func parseLimit(value *string) (*decimal.Decimal, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	d, err := decimal.NewFromString(*value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
@@ -0,0 +1,44 @@
+package statement_handler
+
+import (
+	"account/internal/models"
+	"encoding/csv"
+	"io"
+	"time"
+)
+
+// writeCSV - summary rows followed by entries table
+func writeCSV(w io.Writer, st models.Statement) error {
+	writer := csv.NewWriter(w)
+
+	rows := [][]string{
+		{"account_number", st.AccountNumber},
+		{"account_name", st.AccountName},
+		{"currency", st.Currency},
+		{"month", st.Month},
+		{"opening_balance", st.OpeningBalance},
+		{"total_credits", st.TotalCredits},
+		{"total_debits", st.TotalDebits},
+		{"closing_balance", st.ClosingBalance},
+		{},
+		{"date", "transaction_id", "type", "counterparty", "description", "amount", "balance_after"},
+	}
+
+	for _, e := range st.Entries {
+		rows = append(rows, []string{
+			e.CreatedAt.UTC().Format(time.RFC3339),
+			e.TransactionID.String(),
+			string(e.Type),
+			e.Counterparty,
+			e.Description,
+			e.Amount,
+			e.BalanceAfter,
+		})
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return writer.Error()
+}
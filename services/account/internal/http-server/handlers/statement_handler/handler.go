@@ -0,0 +1,107 @@
+package statement_handler
+
+import (
+	"account/api"
+	"account/internal/http-server/handlers/params"
+	"account/internal/models"
+	slog_helper "account/internal/slog"
+	"context"
+	"fmt"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	"github.com/R1ckNash/Bank/pkg/iban"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+type Path struct {
+	AccountNumber string `path:"accountNumber" validate:"required,iban"`
+}
+
+// Statement formats, selected with format query param
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+	FormatPDF  = "pdf"
+)
+
+type StatementGetter interface {
+	GetAccount(ctx context.Context, number string) (models.Account, error)
+	GetStatement(ctx context.Context, number string, month time.Time) (models.Statement, error)
+}
+
+// New - monthly statement, query params: month (YYYY-MM, required), format (json, csv, pdf)
+func New(log *slog.Logger, getter StatementGetter) func(http.ResponseWriter, *http.Request, api.AccountNumber, api.GetStatementParams) {
+	return func(writer http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber, query api.GetStatementParams) {
+		const op = "handlers.account.statement.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		path := Path{AccountNumber: accountNumber}
+		if err := validation.Params(&path); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+		number := iban.Normalize(path.AccountNumber)
+
+		if err := validation.Params(&query); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		month, err := time.Parse(models.StatementMonthLayout, query.Month)
+		if err != nil {
+			problem.Write(writer, r, problem.BadRequest.New("incorrect month, expected YYYY-MM"))
+			return
+		}
+		format := FormatJSON
+		if query.Format != nil {
+			format = string(*query.Format)
+		}
+
+		log.Info("Received request for statement", slog.String("number", number), slog.String("format", format))
+
+		account, err := getter.GetAccount(r.Context(), number)
+		if err != nil {
+			log.Error("failed to retrieve account", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		if !params.CanAccess(r, account.OwnerID) {
+			problem.Write(writer, r, problem.AccountNotFound.New("account not found"))
+			return
+		}
+
+		statement, err := getter.GetStatement(r.Context(), number, month)
+		if err != nil {
+			log.Error("failed to build statement", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		filename := fmt.Sprintf("statement-%s-%s.%s", statement.AccountNumber, statement.Month, format)
+
+		switch format {
+		case FormatCSV:
+			writer.Header().Set("Content-Type", "text/csv")
+			writer.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+			err = writeCSV(writer, statement)
+		case FormatPDF:
+			writer.Header().Set("Content-Type", "application/pdf")
+			writer.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+			err = writePDF(writer, statement)
+		default:
+			render.JSON(writer, r, statement)
+		}
+		if err != nil {
+			log.Error("failed to write statement", slog_helper.Err(err))
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package statement_handler
+
+import (
+	"account/internal/models"
+	"github.com/R1ckNash/Bank/pkg/iban"
+	"github.com/go-pdf/fpdf"
+	"io"
+)
+
+var pdfColumns = []struct {
+	title string
+	width float64
+	align string
+}{
+	{"Date", 25, "L"},
+	{"Type", 22, "L"},
+	{"Counterparty", 50, "L"},
+	{"Description", 43, "L"},
+	{"Amount", 25, "R"},
+	{"Balance", 25, "R"},
+}
+
+// writePDF - A4 statement with summary and entries table, core fonts only support latin-1
+func writePDF(w io.Writer, st models.Statement) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.SetTitle("Account statement "+st.Month, true)
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 10, "Account statement", "", 1, "L", false, 0, "")
+
+	pdf.SetFont("Helvetica", "", 10)
+	summary := [][2]string{
+		{"Account", iban.Format(st.AccountNumber)},
+		{"Name", tr(st.AccountName)},
+		{"Currency", st.Currency},
+		{"Period", st.PeriodStart.Format("2006-01-02") + " - " + st.PeriodEnd.AddDate(0, 0, -1).Format("2006-01-02")},
+		{"Opening balance", st.OpeningBalance},
+		{"Total credits", st.TotalCredits},
+		{"Total debits", st.TotalDebits},
+		{"Closing balance", st.ClosingBalance},
+	}
+	for _, line := range summary {
+		pdf.CellFormat(40, 6, line[0], "", 0, "L", false, 0, "")
+		pdf.CellFormat(0, 6, line[1], "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(4)
+
+	pdf.SetFont("Helvetica", "B", 9)
+	for _, c := range pdfColumns {
+		pdf.CellFormat(c.width, 7, c.title, "B", 0, c.align, false, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Helvetica", "", 8)
+	for _, e := range st.Entries {
+		values := []string{
+			e.CreatedAt.UTC().Format("2006-01-02"),
+			string(e.Type),
+			iban.Format(e.Counterparty),
+			tr(e.Description),
+			e.Amount,
+			e.BalanceAfter,
+		}
+		for i, c := range pdfColumns {
+			pdf.CellFormat(c.width, 6, truncate(pdf, values[i], c.width), "", 0, c.align, false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+
+	pdf.Ln(4)
+	pdf.SetFont("Helvetica", "I", 8)
+	pdf.CellFormat(0, 5, "Generated at "+st.GeneratedAt.Format("2006-01-02 15:04:05 MST"), "", 1, "L", false, 0, "")
+
+	return pdf.Output(w)
+}
+
+// truncate - cuts text to fit column width
+func truncate(pdf *fpdf.Fpdf, s string, width float64) string {
+	const padding = 2
+	for len(s) > 0 && pdf.GetStringWidth(s) > width-padding {
+		s = s[:len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,79 @@
+package status_handler
+
+import (
+	"account/api"
+	"account/internal/models"
+	slog_helper "account/internal/slog"
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	"github.com/R1ckNash/Bank/pkg/iban"
+	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"log/slog"
+	"net/http"
+)
+
+type Path struct {
+	AccountNumber string `path:"accountNumber" validate:"required,iban"`
+}
+
+type StatusChanger interface {
+	ChangeStatus(ctx context.Context, number string, change models.StatusChange) error
+}
+
+type Request struct {
+	ReasonCode string `json:"reason_code" validate:"required"`
+	Comment    string `json:"comment" validate:"max=512"`
+}
+
+// New - operator endpoint applying action (block, unblock, freeze, unfreeze) to account
+func New(log *slog.Logger, statusChanger StatusChanger, action models.StatusAction) func(http.ResponseWriter, *http.Request, api.AccountNumber) {
+	return func(writer http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber) {
+		const op = "handlers.account.status.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("action", string(action)),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		operatorID, ok := auth.GetUserID(r)
+		if !ok {
+			problem.Write(writer, r, problem.Unauthorized.New(""))
+			return
+		}
+
+		path := Path{AccountNumber: accountNumber}
+		if err := validation.Params(&path); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+		number := iban.Normalize(path.AccountNumber)
+
+		var req Request
+		if err := validation.DecodeJSON(writer, r, &req); err != nil {
+			log.Error("Failed to decode body", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		log.Info("Received request for account status change", slog.String("number", number), slog.String("operator_id", operatorID))
+
+		err := statusChanger.ChangeStatus(r.Context(), number, models.StatusChange{
+			Action:     action,
+			ReasonCode: models.ReasonCode(req.ReasonCode),
+			Comment:    req.Comment,
+			ChangedBy:  operatorID,
+		})
+		if err != nil {
+			log.Error("failed to change account status", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.JSON(writer, r, resp.OK())
+	}
+}
@@ -0,0 +1,112 @@
+package transactions_handler
+
+import (
+	"account/api"
+	"account/internal/http-server/handlers/params"
+	"account/internal/models"
+	slog_helper "account/internal/slog"
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	"github.com/R1ckNash/Bank/pkg/iban"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+type Path struct {
+	AccountNumber string `path:"accountNumber" validate:"required,iban"`
+}
+
+type TransactionLister interface {
+	GetAccount(ctx context.Context, number string) (models.Account, error)
+	ListTransactions(ctx context.Context, number string, filter models.TransactionFilter) (models.TransactionPage, error)
+}
+
+// New - account history, query params: cursor, limit, from, to (YYYY-MM-DD or RFC 3339, to is inclusive date), type (comma separated)
+func New(log *slog.Logger, lister TransactionLister) func(http.ResponseWriter, *http.Request, api.AccountNumber, api.ListTransactionsParams) {
+	return func(writer http.ResponseWriter, r *http.Request, accountNumber api.AccountNumber, query api.ListTransactionsParams) {
+		const op = "handlers.account.transactions.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		path := Path{AccountNumber: accountNumber}
+		if err := validation.Params(&path); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+		number := iban.Normalize(path.AccountNumber)
+
+		if err := validation.Params(&query); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		filter := models.TransactionFilter{
+			Cursor: params.Value(query.Cursor, ""),
+			Limit:  params.Value(query.Limit, models.ListLimitDefault),
+		}
+
+		var err error
+		if filter.From, err = parseTime(params.Value(query.From, ""), false); err != nil {
+			problem.Write(writer, r, problem.BadRequest.New("incorrect from"))
+			return
+		}
+		if filter.To, err = parseTime(params.Value(query.To, ""), true); err != nil {
+			problem.Write(writer, r, problem.BadRequest.New("incorrect to"))
+			return
+		}
+		if types := params.Value(query.Type, ""); types != "" {
+			for _, t := range strings.Split(types, ",") {
+				filter.Types = append(filter.Types, models.TransactionType(strings.TrimSpace(t)))
+			}
+		}
+
+		log.Info("Received request for list transactions", slog.String("number", number))
+
+		account, err := lister.GetAccount(r.Context(), number)
+		if err != nil {
+			log.Error("failed to retrieve account", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		if !params.CanAccess(r, account.OwnerID) {
+			problem.Write(writer, r, problem.AccountNotFound.New("account not found"))
+			return
+		}
+
+		page, err := lister.ListTransactions(r.Context(), number, filter)
+		if err != nil {
+			log.Error("failed to list transactions", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.JSON(writer, r, page)
+	}
+}
+
+// parseTime - date is start of day in UTC, end of range date is inclusive so next day is returned
+func parseTime(value string, endOfRange bool) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if date, err := time.Parse(dateLayout, value); err == nil {
+		if endOfRange {
+			return date.AddDate(0, 0, 1), nil
+		}
+		return date, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
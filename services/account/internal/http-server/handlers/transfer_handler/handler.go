@@ -0,0 +1,117 @@
+package transfer_handler
+
+import (
+	"account/api"
+	"account/internal/http-server/handlers/params"
+	"account/internal/models"
+	slog_helper "account/internal/slog"
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	"github.com/R1ckNash/Bank/pkg/iban"
+	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"net/http"
+)
+
+type Transferer interface {
+	Transfer(ctx context.Context, req models.TransferRequest) (models.Transaction, error)
+}
+
+type Request struct {
+	From        string `json:"from" validate:"required,iban"`
+	To          string `json:"to" validate:"required,iban"`
+	Amount      string `json:"amount" validate:"required,numeric"`
+	QuoteID     string `json:"quote_id" validate:"omitempty,uuid"`
+	Description string `json:"description" validate:"max=256"`
+	// InitiatedBy - owner of source account on whose behalf service makes transfer, ignored for users
+	InitiatedBy string `json:"initiated_by" validate:"omitempty,uuid"`
+}
+
+// New - transfer by account owner between own accounts or by service on behalf of owner to any account (internal router)
+func New(log *slog.Logger, transferer Transferer) func(http.ResponseWriter, *http.Request, *api.IdempotencyKey) {
+	return func(writer http.ResponseWriter, r *http.Request, idempotencyKey *api.IdempotencyKey) {
+		const op = "handlers.transfer.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		var req Request
+		if err := validation.DecodeJSON(writer, r, &req); err != nil {
+			log.Error("Failed to decode body", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		userID, ok := auth.GetUserID(r)
+		serviceID, isService := auth.GetServiceID(r)
+		if isService {
+			log = log.With(slog.String("service_id", serviceID))
+			userID, ok = req.InitiatedBy, true
+		}
+		if !ok {
+			problem.Write(writer, r, problem.Unauthorized.New(""))
+			return
+		}
+
+		initiatedBy, err := uuid.Parse(userID)
+		if err != nil {
+			log.Error("Failed to parse user id")
+			problem.Write(writer, r, problem.BadRequest.New("failed to parse user id"))
+			return
+		}
+
+		from, to := iban.Normalize(req.From), iban.Normalize(req.To)
+		if iban.Validate(from) != nil || iban.Validate(to) != nil {
+			problem.Write(writer, r, problem.BadRequest.New("incorrect account number"))
+			return
+		}
+
+		amount, err := decimal.NewFromString(req.Amount)
+		if err != nil {
+			problem.Write(writer, r, problem.BadRequest.New("invalid amount"))
+			return
+		}
+
+		transfer := models.TransferRequest{
+			From:           from,
+			To:             to,
+			Amount:         amount,
+			Description:    req.Description,
+			IdempotencyKey: params.Value(idempotencyKey, ""),
+			InitiatedBy:    initiatedBy,
+			// customers move money only between own accounts here, other transfers pass risk checks of payments
+			OwnAccountsOnly: !isService,
+		}
+
+		if req.QuoteID != "" {
+			quoteID, err := uuid.Parse(req.QuoteID)
+			if err != nil {
+				problem.Write(writer, r, problem.BadRequest.New("invalid quote_id"))
+				return
+			}
+			transfer.QuoteID = &quoteID
+		}
+
+		log.Info("Received request for transfer", slog.String("from", from), slog.String("to", to))
+
+		trx, err := transferer.Transfer(r.Context(), transfer)
+		if err != nil {
+			log.Error("failed to execute transfer", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.JSON(writer, r, resp.OKWithData(map[string]interface{}{
+			"status":      resp.StatusOK,
+			"transaction": trx,
+		}))
+	}
+}
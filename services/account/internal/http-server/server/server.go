@@ -2,6 +2,7 @@ package http_server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net"
@@ -38,7 +39,7 @@ func (a *Server) Run() error {
 	}
 	log.Info("starting rest server: ", slog.String("addr", l.Addr().String()))
 
-	if err := a.httpServer.Serve(l); err != nil {
+	if err := a.httpServer.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
@@ -1,22 +1,18 @@
 package kafka
 
 import (
-	"github.com/IBM/sarama"
+	slog_helper "account/internal/slog"
+	pkgkafka "github.com/R1ckNash/Bank/pkg/kafka"
 	"log/slog"
 )
 
 type Producer struct {
-	producer sarama.SyncProducer
+	producer *pkgkafka.Producer
 	logger   *slog.Logger
 }
 
 func NewProducer(brokers []string, logger *slog.Logger) (*Producer, error) {
-	config := sarama.NewConfig()
-	config.Producer.RequiredAcks = sarama.WaitForAll
-	config.Producer.Return.Successes = true
-	config.Producer.Partitioner = sarama.NewHashPartitioner
-
-	prod, err := sarama.NewSyncProducer(brokers, config)
+	prod, err := pkgkafka.NewProducer(brokers)
 	if err != nil {
 		return nil, err
 	}
@@ -25,15 +21,9 @@ func NewProducer(brokers []string, logger *slog.Logger) (*Producer, error) {
 }
 
 func (p *Producer) SendMessage(topic, key string, message []byte) error {
-	msg := &sarama.ProducerMessage{
-		Topic: topic,
-		Key:   sarama.StringEncoder(key),
-		Value: sarama.ByteEncoder(message),
-	}
-
-	partition, offset, err := p.producer.SendMessage(msg)
+	partition, offset, err := p.producer.Send(topic, key, message)
 	if err != nil {
-		p.logger.Error("failed to send message", err)
+		p.logger.Error("failed to send message", slog_helper.Err(err))
 		return err
 	}
 
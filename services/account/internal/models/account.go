@@ -2,9 +2,17 @@ package models
 
 import "github.com/google/uuid"
 
+// Account - Balance is ledger balance, AvailableBalance excludes active holds
 type Account struct {
-	OwnerID  uuid.UUID `json:"owner_id"`
-	Name     string    `json:"name"`
-	Currency string    `json:"currency"`
-	Email    string    `json:"email"`
+	ID               int64     `json:"-"`
+	Number           string    `json:"number"`
+	OwnerID          uuid.UUID `json:"owner_id"`
+	Name             string    `json:"name"`
+	Currency         string    `json:"currency"`
+	Product          string    `json:"product"`
+	Balance          string    `json:"balance,omitempty"`
+	AvailableBalance string    `json:"available_balance,omitempty"`
+	OverdraftLimit   string    `json:"overdraft_limit,omitempty"`
+	Email            string    `json:"email"`
+	Status           string    `json:"status,omitempty"`
 }
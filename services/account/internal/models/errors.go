@@ -1,8 +1,42 @@
 package models
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrAlreadyExists       = errors.New("already exists")
+	ErrInternal            = errors.New("internal server error")
+	ErrNotFound            = errors.New("account not found")
+	ErrForbidden           = errors.New("forbidden")
+	ErrInvalidTransition   = errors.New("invalid account status transition")
+	ErrInvalidReasonCode   = errors.New("invalid reason code")
+	ErrNonZeroBalance      = errors.New("account balance is not zero")
+	ErrUnsupportedCurrency = errors.New("unsupported currency")
+	ErrAccountNotActive    = errors.New("account is not active")
+	ErrInsufficientFunds   = errors.New("insufficient funds")
+	ErrInvalidAmount       = errors.New("invalid amount")
+	ErrSameAccount         = errors.New("source and destination accounts are the same")
+	ErrRateNotFound        = errors.New("fx rate not found")
+	ErrQuoteNotFound       = errors.New("fx quote not found")
+	ErrQuoteExpired        = errors.New("fx quote expired")
+	ErrQuoteMismatch       = errors.New("fx quote doesn't match transfer currencies")
+	ErrInvalidRates        = errors.New("invalid fx rates")
+	ErrInvalidCursor       = errors.New("invalid cursor")
+	ErrHoldNotFound        = errors.New("hold not found")
+	ErrHoldNotActive       = errors.New("hold is not active")
+	ErrInvalidExpiry       = errors.New("invalid hold expiry")
+	ErrInvalidOverdraft    = errors.New("invalid overdraft settings")
+	ErrUnknownProduct      = errors.New("unknown product")
+	ErrInvalidLimits       = errors.New("invalid limits")
+	ErrLimitExceeded       = errors.New("limit exceeded")
+	ErrIdempotencyMismatch = errors.New("idempotency key was used for different request")
+)
 
+// Exceeded limits, all of them match ErrLimitExceeded
 var (
-	ErrAlreadyExists = errors.New("already exists")
-	ErrInternal      = errors.New("internal server error")
+	ErrTransactionLimitExceeded = fmt.Errorf("%w: per transaction", ErrLimitExceeded)
+	ErrDailyLimitExceeded       = fmt.Errorf("%w: daily", ErrLimitExceeded)
+	ErrMonthlyLimitExceeded     = fmt.Errorf("%w: monthly", ErrLimitExceeded)
 )
@@ -0,0 +1,14 @@
+package models
+
+const (
+	ListLimitDefault = 20
+	ListLimitMax     = 100
+)
+
+// AccountFilter - filters and pagination for list of owner's accounts
+type AccountFilter struct {
+	Currency string
+	Status   AccountStatus
+	Limit    int
+	Offset   int
+}
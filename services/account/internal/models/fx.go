@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	"github.com/R1ckNash/Bank/pkg/currency"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Sources of FX rate snapshots
+const (
+	FXSourceAdminUpload = "admin_upload"
+	FXSourceFileFeed    = "file_feed"
+)
+
+// FXRate - 1 Base = Rate Quote (mid-market)
+type FXRate struct {
+	Base  string          `json:"base"`
+	Quote string          `json:"quote"`
+	Rate  decimal.Decimal `json:"rate"`
+}
+
+// FXRateSnapshot - set of rates valid in [ValidFrom, ValidTo)
+type FXRateSnapshot struct {
+	ID        int64     `json:"id"`
+	Source    string    `json:"source"`
+	ValidFrom time.Time `json:"valid_from"`
+	ValidTo   time.Time `json:"valid_to"`
+	CreatedBy string    `json:"created_by"`
+	Rates     []FXRate  `json:"rates"`
+}
+
+// FXQuote - customer rate locked until ExpiresAt: Rate = MidRate * (1 - Spread)
+type FXQuote struct {
+	ID              uuid.UUID       `json:"id"`
+	SnapshotID      int64           `json:"-"`
+	From            string          `json:"from"`
+	To              string          `json:"to"`
+	MidRate         decimal.Decimal `json:"mid_rate"`
+	Spread          decimal.Decimal `json:"spread"`
+	Rate            decimal.Decimal `json:"rate"`
+	Amount          string          `json:"amount,omitempty"`
+	ConvertedAmount string          `json:"converted_amount,omitempty"`
+	ExpiresAt       time.Time       `json:"expires_at"`
+}
+
+// Convert - amount in From currency converted with customer rate and rounded to minor units of to,
+// quoted and credited amounts are both calculated here so they are always the same
+func (q FXQuote) Convert(amount decimal.Decimal, to currency.Currency) decimal.Decimal {
+	return to.Round(amount.Mul(q.Rate))
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// HoldStatus - hold is active until it's captured, released or expired
+type HoldStatus string
+
+const (
+	HoldActive   HoldStatus = "active"
+	HoldCaptured HoldStatus = "captured"
+	HoldReleased HoldStatus = "released"
+	HoldExpired  HoldStatus = "expired"
+)
+
+// PlaceHoldRequest - reserve Amount on account Number until ExpiresAt
+type PlaceHoldRequest struct {
+	Number         string
+	Amount         decimal.Decimal
+	Reference      string
+	Description    string
+	ExpiresAt      time.Time
+	IdempotencyKey string
+	CreatedBy      string
+}
+
+// Hold - reserved funds, they are excluded from available balance while hold is active
+type Hold struct {
+	ID             uuid.UUID  `json:"id"`
+	AccountNumber  string     `json:"account_number"`
+	Amount         string     `json:"amount"`
+	CapturedAmount string     `json:"captured_amount"`
+	Currency       string     `json:"currency"`
+	Status         HoldStatus `json:"status"`
+	Reference      string     `json:"reference,omitempty"`
+	Description    string     `json:"description,omitempty"`
+	TransactionID  *uuid.UUID `json:"transaction_id,omitempty"`
+	ExpiresAt      time.Time  `json:"expires_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Counter periods of outgoing amounts, boundaries are in UTC
+const (
+	PeriodDay   = "day"
+	PeriodMonth = "month"
+)
+
+// Limits - maximum outgoing amounts in account currency, nil means unlimited
+type Limits struct {
+	PerTransaction *decimal.Decimal `json:"per_transaction,omitempty"`
+	Daily          *decimal.Decimal `json:"daily,omitempty"`
+	Monthly        *decimal.Decimal `json:"monthly,omitempty"`
+}
+
+// Override - limits with fields set in o replacing ones of l
+func (l Limits) Override(o Limits) Limits {
+	if o.PerTransaction != nil {
+		l.PerTransaction = o.PerTransaction
+	}
+	if o.Daily != nil {
+		l.Daily = o.Daily
+	}
+	if o.Monthly != nil {
+		l.Monthly = o.Monthly
+	}
+	return l
+}
+
+// LimitsSettings - per-account limits set by operator, they override limits of product
+type LimitsSettings struct {
+	Limits
+	ChangedBy string
+}
+
+// AccountLimits - effective limits of account and amounts already sent in current day and month
+type AccountLimits struct {
+	AccountNumber string    `json:"account_number"`
+	Currency      string    `json:"currency"`
+	Product       string    `json:"product"`
+	Effective     Limits    `json:"effective"`
+	Override      Limits    `json:"override"`
+	DailyUsed     string    `json:"daily_used"`
+	MonthlyUsed   string    `json:"monthly_used"`
+	CalculatedAt  time.Time `json:"calculated_at"`
+}
+
+// PeriodStart - first day of UTC day or month containing t
+func PeriodStart(period string, t time.Time) time.Time {
+	t = t.UTC()
+	if period == PeriodMonth {
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,13 @@
+package models
+
+import "github.com/shopspring/decimal"
+
+// InterestKindOverdraft - interest charged on negative balance
+const InterestKindOverdraft = "overdraft"
+
+// OverdraftSettings - Limit in account currency, Rate is annual, e.g. 0.15 for 15%
+type OverdraftSettings struct {
+	Limit     decimal.Decimal
+	Rate      decimal.Decimal
+	ChangedBy string
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Account products
+const (
+	ProductChecking = "checking"
+	ProductSavings  = "savings"
+)
+
+// InterestKindCredit - interest paid on positive balance
+const InterestKindCredit = "credit"
+
+// Product - account conditions, InterestRate is annual and paid on positive balance,
+// Limits are default outgoing limits of its accounts by their currency
+type Product struct {
+	Code         string            `json:"code"`
+	Name         string            `json:"name"`
+	InterestRate decimal.Decimal   `json:"interest_rate"`
+	Limits       map[string]Limits `json:"limits,omitempty"`
+}
+
+// LimitsIn - default limits of accounts in currency, unlimited when product has none
+func (p Product) LimitsIn(currency string) Limits {
+	return p.Limits[currency]
+}
+
+// ProductCatalog - products offered by deployment by code
+type ProductCatalog map[string]Product
+
+func (c ProductCatalog) Get(code string) (Product, error) {
+	p, ok := c[code]
+	if !ok {
+		return Product{}, fmt.Errorf("%w: %q", ErrUnknownProduct, code)
+	}
+	return p, nil
+}
+
+// InterestBearing - codes of products with positive interest rate
+func (c ProductCatalog) InterestBearing() []string {
+	codes := make([]string, 0, len(c))
+	for code, p := range c {
+		if p.InterestRate.IsPositive() {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
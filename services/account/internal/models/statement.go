@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// StatementMonthLayout - format of statement month, e.g. "2024-03"
+const StatementMonthLayout = "2006-01"
+
+// Statement - monthly account statement, period is [PeriodStart, PeriodEnd)
+type Statement struct {
+	AccountNumber  string    `json:"account_number"`
+	AccountName    string    `json:"account_name"`
+	Currency       string    `json:"currency"`
+	Month          string    `json:"month"`
+	PeriodStart    time.Time `json:"period_start"`
+	PeriodEnd      time.Time `json:"period_end"`
+	OpeningBalance string    `json:"opening_balance"`
+	ClosingBalance string    `json:"closing_balance"`
+	TotalCredits   string    `json:"total_credits"`
+	TotalDebits    string    `json:"total_debits"`
+	Entries        []Entry   `json:"entries"`
+	GeneratedAt    time.Time `json:"generated_at"`
+}
@@ -0,0 +1,92 @@
+package models
+
+// AccountStatus - state of account lifecycle
+type AccountStatus string
+
+const (
+	StatusActive  AccountStatus = "active"
+	StatusFrozen  AccountStatus = "frozen"
+	StatusBlocked AccountStatus = "blocked"
+	StatusClosed  AccountStatus = "closed"
+)
+
+// IsValid - known status
+func (s AccountStatus) IsValid() bool {
+	switch s {
+	case StatusActive, StatusFrozen, StatusBlocked, StatusClosed:
+		return true
+	}
+	return false
+}
+
+// StatusAction - what moves account from one status to another
+type StatusAction string
+
+const (
+	ActionFreeze   StatusAction = "freeze"
+	ActionUnfreeze StatusAction = "unfreeze"
+	ActionBlock    StatusAction = "block"
+	ActionUnblock  StatusAction = "unblock"
+	ActionClose    StatusAction = "close"
+)
+
+// ReasonCode - why status was changed, stored in status history
+type ReasonCode string
+
+const (
+	ReasonFraudSuspected   ReasonCode = "fraud_suspected"
+	ReasonComplianceReview ReasonCode = "compliance_review"
+	ReasonCourtOrder       ReasonCode = "court_order"
+	ReasonCustomerRequest  ReasonCode = "customer_request"
+	ReasonIssueResolved    ReasonCode = "issue_resolved"
+	ReasonOwnerClosure     ReasonCode = "owner_closure"
+)
+
+var reasonCodes = map[ReasonCode]struct{}{
+	ReasonFraudSuspected:   {},
+	ReasonComplianceReview: {},
+	ReasonCourtOrder:       {},
+	ReasonCustomerRequest:  {},
+	ReasonIssueResolved:    {},
+	ReasonOwnerClosure:     {},
+}
+
+// IsValid - known reason code
+func (r ReasonCode) IsValid() bool {
+	_, ok := reasonCodes[r]
+	return ok
+}
+
+// transitions - account status state machine, closed is terminal
+var transitions = map[AccountStatus]map[StatusAction]AccountStatus{
+	StatusActive: {
+		ActionFreeze: StatusFrozen,
+		ActionBlock:  StatusBlocked,
+		ActionClose:  StatusClosed,
+	},
+	StatusFrozen: {
+		ActionUnfreeze: StatusActive,
+		ActionBlock:    StatusBlocked,
+	},
+	StatusBlocked: {
+		ActionUnblock: StatusActive,
+	},
+}
+
+// Apply - returns status after action or ErrInvalidTransition
+func (s AccountStatus) Apply(action StatusAction) (AccountStatus, error) {
+	to, ok := transitions[s][action]
+	if !ok {
+		return s, ErrInvalidTransition
+	}
+
+	return to, nil
+}
+
+// StatusChange - request to move account through the lifecycle
+type StatusChange struct {
+	Action     StatusAction
+	ReasonCode ReasonCode
+	Comment    string
+	ChangedBy  string
+}
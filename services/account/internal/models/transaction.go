@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TransactionType - kind of money movement
+type TransactionType string
+
+const (
+	TransactionTransfer    TransactionType = "transfer"
+	TransactionHoldCapture TransactionType = "hold_capture"
+	// TransactionOverdraftInterest - monthly posting of interest accrued on negative balance
+	TransactionOverdraftInterest TransactionType = "overdraft_interest"
+	// TransactionInterest - monthly capitalization of interest accrued on positive balance
+	TransactionInterest TransactionType = "interest"
+)
+
+// TransferRequest - move Amount (in currency of From account) to To account
+type TransferRequest struct {
+	From           string
+	To             string
+	Amount         decimal.Decimal
+	QuoteID        *uuid.UUID
+	Description    string
+	IdempotencyKey string
+	InitiatedBy    uuid.UUID
+	// OwnAccountsOnly - destination has to belong to initiator too, set for customers calling service directly,
+	// transfers to other owners are made by payment service after its risk checks
+	OwnAccountsOnly bool
+}
+
+// Transaction - executed money movement, FX fields are set for cross-currency transfers
+type Transaction struct {
+	ID             uuid.UUID        `json:"id"`
+	Type           TransactionType  `json:"type"`
+	From           string           `json:"from,omitempty"`
+	To             string           `json:"to,omitempty"`
+	DebitAmount    string           `json:"debit_amount"`
+	DebitCurrency  string           `json:"debit_currency"`
+	CreditAmount   string           `json:"credit_amount"`
+	CreditCurrency string           `json:"credit_currency"`
+	FXRate         *decimal.Decimal `json:"fx_rate,omitempty"`
+	FXMidRate      *decimal.Decimal `json:"fx_mid_rate,omitempty"`
+	FXSpread       *decimal.Decimal `json:"fx_spread,omitempty"`
+	Description    string           `json:"description,omitempty"`
+	CreatedAt      time.Time        `json:"created_at"`
+}
+
+// Entry - line of account history, Amount is negative for debit
+type Entry struct {
+	TransactionID uuid.UUID       `json:"transaction_id"`
+	Type          TransactionType `json:"type"`
+	Amount        string          `json:"amount"`
+	Currency      string          `json:"currency"`
+	BalanceAfter  string          `json:"balance_after"`
+	Counterparty  string          `json:"counterparty,omitempty"`
+	Description   string          `json:"description,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// TransactionFilter - page of account history, newest first; From inclusive, To exclusive
+type TransactionFilter struct {
+	Cursor string
+	From   time.Time
+	To     time.Time
+	Types  []TransactionType
+	Limit  int
+}
+
+// TransactionPage - NextCursor is empty on last page
+type TransactionPage struct {
+	Entries    []Entry `json:"entries"`
+	NextCursor string  `json:"next_cursor,omitempty"`
+}
@@ -12,9 +12,10 @@ import (
 func (s *AccountStorage) CreateAccount(ctx context.Context, acc *Account) error {
 	const api = "account_storage.CreateAccount"
 
-	query := `insert into account (owner_id, name, currency, email, is_blocked, balance, created_at, updated_at) values ($1, $2, $3, $4, $5, $6, $7, $8) returning id`
+	query := `insert into account (number, owner_id, name, currency, product, email, status, balance, created_at, updated_at) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) returning id`
 
-	if _, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, acc.OwnerID, acc.Name, acc.Currency, acc.Email, acc.IsBlocked, acc.Balance, acc.CreatedAt, acc.UpdatedAt); err != nil {
+	err := s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, acc.Number, acc.OwnerID, acc.Name, acc.Currency, acc.Product, acc.Email, acc.Status, acc.Balance, acc.CreatedAt, acc.UpdatedAt).Scan(&acc.ID)
+	if err != nil {
 		var pgError *pgconn.PgError
 		if errors.As(err, &pgError) && pgError.Code == pgerrcode.UniqueViolation {
 			return pkgerrors.Wrap(api, models.ErrAlreadyExists)
@@ -0,0 +1,135 @@
+package account_storage
+
+import (
+	"context"
+	"fmt"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/shopspring/decimal"
+	"strings"
+	"time"
+)
+
+const selectEntry = `SELECT id, account_id, transaction_id, type, amount, currency, balance_after, counterparty, description, created_at FROM account_entries`
+
+// EntryFilter - page of account entries, newest first, entries with id < BeforeID
+type EntryFilter struct {
+	BeforeID int64
+	From     time.Time
+	To       time.Time
+	Types    []string
+	Limit    int
+}
+
+func (s *AccountStorage) CreateEntry(ctx context.Context, e *Entry) error {
+	const api = "account_storage.CreateEntry"
+
+	query := `INSERT INTO account_entries (account_id, transaction_id, type, amount, currency, balance_after, counterparty, description, created_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id`
+
+	err := s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, e.AccountID, e.TransactionID, e.Type, e.Amount, e.Currency, e.BalanceAfter, e.Counterparty, e.Description, e.CreatedAt).Scan(&e.ID)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+// ListEntries - account entries matching filter ordered by id desc
+func (s *AccountStorage) ListEntries(ctx context.Context, accountID int64, filter EntryFilter) ([]Entry, error) {
+	const api = "account_storage.ListEntries"
+
+	conditions := []string{"account_id=$1"}
+	args := []interface{}{accountID}
+
+	if filter.BeforeID > 0 {
+		args = append(args, filter.BeforeID)
+		conditions = append(conditions, fmt.Sprintf("id<$%d", len(args)))
+	}
+	if !filter.From.IsZero() {
+		args = append(args, filter.From)
+		conditions = append(conditions, fmt.Sprintf("created_at>=$%d", len(args)))
+	}
+	if !filter.To.IsZero() {
+		args = append(args, filter.To)
+		conditions = append(conditions, fmt.Sprintf("created_at<$%d", len(args)))
+	}
+	if len(filter.Types) > 0 {
+		args = append(args, filter.Types)
+		conditions = append(conditions, fmt.Sprintf("type=ANY($%d)", len(args)))
+	}
+
+	args = append(args, filter.Limit)
+	query := fmt.Sprintf(selectEntry+` WHERE %s ORDER BY id DESC LIMIT $%d`, strings.Join(conditions, " AND "), len(args))
+
+	entries, err := s.listEntries(ctx, query, args...)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return entries, nil
+}
+
+// ListEntriesForPeriod - all account entries in [from, to) in booking order
+func (s *AccountStorage) ListEntriesForPeriod(ctx context.Context, accountID int64, from, to time.Time) ([]Entry, error) {
+	const api = "account_storage.ListEntriesForPeriod"
+	query := selectEntry + ` WHERE account_id=$1 AND created_at>=$2 AND created_at<$3 ORDER BY id`
+
+	entries, err := s.listEntries(ctx, query, accountID, from, to)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return entries, nil
+}
+
+// GetBalanceAt - balance after last entry booked before moment; balance of account funded before
+// entries were introduced is current balance minus all its entries
+func (s *AccountStorage) GetBalanceAt(ctx context.Context, accountID int64, at time.Time) (decimal.Decimal, error) {
+	const api = "account_storage.GetBalanceAt"
+
+	query := `SELECT COALESCE(
+				(SELECT balance_after FROM account_entries WHERE account_id=$1 AND created_at<$2 ORDER BY id DESC LIMIT 1),
+				(SELECT a.balance - COALESCE((SELECT SUM(e.amount) FROM account_entries e WHERE e.account_id=a.id), 0)
+				 FROM account a WHERE a.id=$1))`
+
+	var balance decimal.NullDecimal
+	if err := s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, accountID, at).Scan(&balance); err != nil {
+		return decimal.Decimal{}, pkgerrors.Wrap(api, err)
+	}
+	if !balance.Valid {
+		return decimal.Zero, nil
+	}
+
+	return balance.Decimal, nil
+}
+
+func (s *AccountStorage) listEntries(ctx context.Context, query string, args ...interface{}) ([]Entry, error) {
+	rows, err := s.driver.GetQueryEngine(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]Entry, 0)
+	for rows.Next() {
+		var e Entry
+		err = rows.Scan(
+			&e.ID,
+			&e.AccountID,
+			&e.TransactionID,
+			&e.Type,
+			&e.Amount,
+			&e.Currency,
+			&e.BalanceAfter,
+			&e.Counterparty,
+			&e.Description,
+			&e.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, e)
+	}
+
+	return result, rows.Err()
+}
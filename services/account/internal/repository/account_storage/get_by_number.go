@@ -0,0 +1,70 @@
+package account_storage
+
+import (
+	"account/internal/models"
+	"context"
+	"errors"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/jackc/pgx/v5"
+)
+
+const selectAccount = `SELECT id, number, owner_id, name, currency, product, email, status, balance, held_amount, overdraft_limit, overdraft_rate, created_at, updated_at FROM account`
+
+func (s *AccountStorage) GetByNumber(ctx context.Context, number string) (Account, error) {
+	const api = "account_storage.GetByNumber"
+	query := selectAccount + ` WHERE number=$1`
+
+	account, err := s.get(ctx, query, number)
+	if err != nil {
+		return Account{}, pkgerrors.Wrap(api, err)
+	}
+
+	return account, nil
+}
+
+// GetByNumberForUpdate - locks account row until the end of transaction
+func (s *AccountStorage) GetByNumberForUpdate(ctx context.Context, number string) (Account, error) {
+	const api = "account_storage.GetByNumberForUpdate"
+	query := selectAccount + ` WHERE number=$1 FOR UPDATE`
+
+	account, err := s.get(ctx, query, number)
+	if err != nil {
+		return Account{}, pkgerrors.Wrap(api, err)
+	}
+
+	return account, nil
+}
+
+func (s *AccountStorage) get(ctx context.Context, query string, args ...interface{}) (Account, error) {
+	account, err := scanAccount(s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Account{}, models.ErrNotFound
+		}
+		return Account{}, err
+	}
+
+	return account, nil
+}
+
+// scanAccount - row of selectAccount
+func scanAccount(row pgx.Row) (Account, error) {
+	var account Account
+	err := row.Scan(
+		&account.ID,
+		&account.Number,
+		&account.OwnerID,
+		&account.Name,
+		&account.Currency,
+		&account.Product,
+		&account.Email,
+		&account.Status,
+		&account.Balance,
+		&account.Held,
+		&account.OverdraftLimit,
+		&account.OverdraftRate,
+		&account.CreatedAt,
+		&account.UpdatedAt,
+	)
+	return account, err
+}
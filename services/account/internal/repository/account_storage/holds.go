@@ -0,0 +1,186 @@
+package account_storage
+
+import (
+	"account/internal/models"
+	"context"
+	"errors"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+const selectHold = `SELECT h.id, h.account_id, COALESCE(a.number, ''), h.amount, h.captured_amount, h.currency, h.status,
+			  h.reference, h.description, h.idempotency_key, h.transaction_id, h.created_by, h.expires_at, h.created_at, h.updated_at
+			  FROM holds h
+			  JOIN account a ON a.id = h.account_id`
+
+// AddHeld - adds delta (may be negative) to reserved amount of account
+func (s *AccountStorage) AddHeld(ctx context.Context, accountID int64, delta decimal.Decimal) error {
+	const api = "account_storage.AddHeld"
+
+	query := `UPDATE account SET held_amount=held_amount+$1, updated_at=$2 WHERE id=$3`
+
+	if _, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, delta, time.Now(), accountID); err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+func (s *AccountStorage) CreateHold(ctx context.Context, h *Hold) error {
+	const api = "account_storage.CreateHold"
+
+	query := `INSERT INTO holds (id, account_id, amount, captured_amount, currency, status, reference, description, idempotency_key, created_by, expires_at, created_at, updated_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+
+	_, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, h.ID, h.AccountID, h.Amount, h.CapturedAmount, h.Currency, h.Status,
+		h.Reference, h.Description, h.IdempotencyKey, h.CreatedBy, h.ExpiresAt, h.CreatedAt, h.UpdatedAt)
+	if err != nil {
+		var pgError *pgconn.PgError
+		if errors.As(err, &pgError) && pgError.Code == pgerrcode.UniqueViolation {
+			return pkgerrors.Wrap(api, models.ErrAlreadyExists)
+		}
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+// UpdateHold - saves status, captured amount and settlement transaction
+func (s *AccountStorage) UpdateHold(ctx context.Context, h *Hold) error {
+	const api = "account_storage.UpdateHold"
+
+	query := `UPDATE holds SET status=$1, captured_amount=$2, transaction_id=$3, updated_at=$4 WHERE id=$5`
+
+	if _, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, h.Status, h.CapturedAmount, h.TransactionID, h.UpdatedAt, h.ID); err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+func (s *AccountStorage) GetHold(ctx context.Context, id uuid.UUID) (Hold, error) {
+	const api = "account_storage.GetHold"
+
+	hold, err := s.getHold(ctx, selectHold+` WHERE h.id=$1`, id)
+	if err != nil {
+		return Hold{}, pkgerrors.Wrap(api, err)
+	}
+
+	return hold, nil
+}
+
+// GetHoldForUpdate - locks hold row until the end of transaction, account must be locked first
+func (s *AccountStorage) GetHoldForUpdate(ctx context.Context, id uuid.UUID) (Hold, error) {
+	const api = "account_storage.GetHoldForUpdate"
+
+	hold, err := s.getHold(ctx, selectHold+` WHERE h.id=$1 FOR UPDATE OF h`, id)
+	if err != nil {
+		return Hold{}, pkgerrors.Wrap(api, err)
+	}
+
+	return hold, nil
+}
+
+func (s *AccountStorage) GetHoldByIdempotencyKey(ctx context.Context, createdBy, key string) (Hold, error) {
+	const api = "account_storage.GetHoldByIdempotencyKey"
+
+	hold, err := s.getHold(ctx, selectHold+` WHERE h.created_by=$1 AND h.idempotency_key=$2`, createdBy, key)
+	if err != nil {
+		return Hold{}, pkgerrors.Wrap(api, err)
+	}
+
+	return hold, nil
+}
+
+// ListHolds - holds of account, newest first, all statuses if status is empty
+func (s *AccountStorage) ListHolds(ctx context.Context, accountID int64, status string) ([]Hold, error) {
+	const api = "account_storage.ListHolds"
+
+	query := selectHold + ` WHERE h.account_id=$1 AND ($2='' OR h.status=$2) ORDER BY h.created_at DESC`
+
+	rows, err := s.driver.GetQueryEngine(ctx).Query(ctx, query, accountID, status)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+	defer rows.Close()
+
+	result := make([]Hold, 0)
+	for rows.Next() {
+		hold, err := scanHold(rows)
+		if err != nil {
+			return nil, pkgerrors.Wrap(api, err)
+		}
+		result = append(result, hold)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return result, nil
+}
+
+// ListExpiredHoldIDs - active holds expired before moment, oldest first
+func (s *AccountStorage) ListExpiredHoldIDs(ctx context.Context, at time.Time, limit int) ([]uuid.UUID, error) {
+	const api = "account_storage.ListExpiredHoldIDs"
+
+	query := `SELECT id FROM holds WHERE status=$1 AND expires_at<=$2 ORDER BY expires_at LIMIT $3`
+
+	rows, err := s.driver.GetQueryEngine(ctx).Query(ctx, query, string(models.HoldActive), at, limit)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		if err = rows.Scan(&id); err != nil {
+			return nil, pkgerrors.Wrap(api, err)
+		}
+		ids = append(ids, id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return ids, nil
+}
+
+func (s *AccountStorage) getHold(ctx context.Context, query string, args ...interface{}) (Hold, error) {
+	hold, err := scanHold(s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Hold{}, models.ErrHoldNotFound
+		}
+		return Hold{}, err
+	}
+
+	return hold, nil
+}
+
+func scanHold(row pgx.Row) (Hold, error) {
+	var h Hold
+	err := row.Scan(
+		&h.ID,
+		&h.AccountID,
+		&h.AccountNumber,
+		&h.Amount,
+		&h.CapturedAmount,
+		&h.Currency,
+		&h.Status,
+		&h.Reference,
+		&h.Description,
+		&h.IdempotencyKey,
+		&h.TransactionID,
+		&h.CreatedBy,
+		&h.ExpiresAt,
+		&h.CreatedAt,
+		&h.UpdatedAt,
+	)
+	return h, err
+}
@@ -0,0 +1,150 @@
+package account_storage
+
+import (
+	"context"
+	"errors"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// CreateAccrual - stores daily accrual, returns false if it already exists for account, date and kind
+func (s *AccountStorage) CreateAccrual(ctx context.Context, a *InterestAccrual) (bool, error) {
+	const api = "account_storage.CreateAccrual"
+
+	query := `INSERT INTO interest_accruals (account_id, accrual_date, kind, balance, rate, amount, created_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7)
+			  ON CONFLICT (account_id, accrual_date, kind) DO NOTHING`
+
+	tag, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, a.AccountID, a.AccrualDate, a.Kind, a.Balance, a.Rate, a.Amount, a.CreatedAt)
+	if err != nil {
+		return false, pkgerrors.Wrap(api, err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// ListAccountsWithUnpostedAccruals - numbers of accounts having accruals of kind before date not posted yet
+func (s *AccountStorage) ListAccountsWithUnpostedAccruals(ctx context.Context, kind string, before time.Time) ([]string, error) {
+	const api = "account_storage.ListAccountsWithUnpostedAccruals"
+
+	query := `SELECT DISTINCT a.number
+			  FROM interest_accruals i
+			  JOIN account a ON a.id = i.account_id
+			  WHERE i.transaction_id IS NULL AND i.kind=$1 AND i.accrual_date<$2 AND a.number IS NOT NULL
+			  ORDER BY a.number`
+
+	rows, err := s.driver.GetQueryEngine(ctx).Query(ctx, query, kind, before)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+	defer rows.Close()
+
+	numbers := make([]string, 0)
+	for rows.Next() {
+		var number string
+		if err = rows.Scan(&number); err != nil {
+			return nil, pkgerrors.Wrap(api, err)
+		}
+		numbers = append(numbers, number)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return numbers, nil
+}
+
+// ListUnpostedAccrualsForUpdate - locks not posted accruals of kind before date, account must be locked first
+func (s *AccountStorage) ListUnpostedAccrualsForUpdate(ctx context.Context, accountID int64, kind string, before time.Time) ([]InterestAccrual, error) {
+	const api = "account_storage.ListUnpostedAccrualsForUpdate"
+
+	query := `SELECT account_id, accrual_date, kind, balance, rate, amount, transaction_id, created_at
+			  FROM interest_accruals
+			  WHERE account_id=$1 AND kind=$2 AND accrual_date<$3 AND transaction_id IS NULL
+			  ORDER BY accrual_date
+			  FOR UPDATE`
+
+	rows, err := s.driver.GetQueryEngine(ctx).Query(ctx, query, accountID, kind, before)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+	defer rows.Close()
+
+	result := make([]InterestAccrual, 0)
+	for rows.Next() {
+		var a InterestAccrual
+		err = rows.Scan(&a.AccountID, &a.AccrualDate, &a.Kind, &a.Balance, &a.Rate, &a.Amount, &a.TransactionID, &a.CreatedAt)
+		if err != nil {
+			return nil, pkgerrors.Wrap(api, err)
+		}
+		result = append(result, a)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return result, nil
+}
+
+// MarkAccrualsPosted - links not posted accruals of kind before date with posting transaction
+func (s *AccountStorage) MarkAccrualsPosted(ctx context.Context, accountID int64, kind string, before time.Time, transactionID uuid.UUID) error {
+	const api = "account_storage.MarkAccrualsPosted"
+
+	query := `UPDATE interest_accruals SET transaction_id=$1
+			  WHERE account_id=$2 AND kind=$3 AND accrual_date<$4 AND transaction_id IS NULL`
+
+	if _, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, transactionID, accountID, kind, before); err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+// GetInterestCarryForUpdate - remainder of previous postings of kind, zero if there is none
+func (s *AccountStorage) GetInterestCarryForUpdate(ctx context.Context, accountID int64, kind string) (decimal.Decimal, error) {
+	const api = "account_storage.GetInterestCarryForUpdate"
+
+	query := `SELECT amount FROM interest_carry WHERE account_id=$1 AND kind=$2 FOR UPDATE`
+
+	var amount decimal.Decimal
+	err := s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, accountID, kind).Scan(&amount)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return decimal.Zero, nil
+		}
+		return decimal.Decimal{}, pkgerrors.Wrap(api, err)
+	}
+
+	return amount, nil
+}
+
+// SetInterestCarry - replaces remainder of kind left after posting
+func (s *AccountStorage) SetInterestCarry(ctx context.Context, accountID int64, kind string, amount decimal.Decimal) error {
+	const api = "account_storage.SetInterestCarry"
+
+	query := `INSERT INTO interest_carry (account_id, kind, amount, updated_at) VALUES ($1, $2, $3, $4)
+			  ON CONFLICT (account_id, kind) DO UPDATE SET amount=EXCLUDED.amount, updated_at=EXCLUDED.updated_at`
+
+	if _, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, accountID, kind, amount, time.Now()); err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+// ListProductAccounts - page of not closed accounts of products, ordered by id, ids > afterID
+func (s *AccountStorage) ListProductAccounts(ctx context.Context, products []string, afterID int64, limit int) ([]Account, error) {
+	const api = "account_storage.ListProductAccounts"
+
+	query := selectAccount + ` WHERE product=ANY($1) AND status <> 'closed' AND id > $2 ORDER BY id LIMIT $3`
+
+	accounts, err := s.list(ctx, query, products, afterID, limit)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return accounts, nil
+}
@@ -0,0 +1,86 @@
+package account_storage
+
+import (
+	"context"
+	"errors"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// GetLimits - per-account limits, all of them are nil when account has no overrides
+func (s *AccountStorage) GetLimits(ctx context.Context, accountID int64) (Limits, error) {
+	const api = "account_storage.GetLimits"
+
+	query := `SELECT account_id, per_transaction, daily, monthly, updated_by, updated_at FROM account_limits WHERE account_id=$1`
+
+	l := Limits{AccountID: accountID}
+	err := s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, accountID).Scan(
+		&l.AccountID,
+		&l.PerTransaction,
+		&l.Daily,
+		&l.Monthly,
+		&l.UpdatedBy,
+		&l.UpdatedAt,
+	)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return Limits{}, pkgerrors.Wrap(api, err)
+	}
+
+	return l, nil
+}
+
+// UpsertLimits - replaces per-account limits
+func (s *AccountStorage) UpsertLimits(ctx context.Context, l *Limits) error {
+	const api = "account_storage.UpsertLimits"
+
+	query := `INSERT INTO account_limits (account_id, per_transaction, daily, monthly, updated_by, updated_at)
+			  VALUES ($1, $2, $3, $4, $5, $6)
+			  ON CONFLICT (account_id) DO UPDATE
+			  SET per_transaction=EXCLUDED.per_transaction, daily=EXCLUDED.daily, monthly=EXCLUDED.monthly,
+			      updated_by=EXCLUDED.updated_by, updated_at=EXCLUDED.updated_at`
+
+	_, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, l.AccountID, l.PerTransaction, l.Daily, l.Monthly, l.UpdatedBy, l.UpdatedAt)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+// AddOutflow - atomically adds amount to counter of period starting at periodStart, returns new total
+func (s *AccountStorage) AddOutflow(ctx context.Context, accountID int64, period string, periodStart time.Time, amount decimal.Decimal) (decimal.Decimal, error) {
+	const api = "account_storage.AddOutflow"
+
+	query := `INSERT INTO outflow_counters (account_id, period, period_start, amount, count)
+			  VALUES ($1, $2, $3, $4, 1)
+			  ON CONFLICT (account_id, period, period_start) DO UPDATE
+			  SET amount=outflow_counters.amount+EXCLUDED.amount, count=outflow_counters.count+1
+			  RETURNING amount`
+
+	var total decimal.Decimal
+	if err := s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, accountID, period, periodStart, amount).Scan(&total); err != nil {
+		return decimal.Decimal{}, pkgerrors.Wrap(api, err)
+	}
+
+	return total, nil
+}
+
+// GetOutflow - amount sent in period starting at periodStart, zero if nothing was sent
+func (s *AccountStorage) GetOutflow(ctx context.Context, accountID int64, period string, periodStart time.Time) (decimal.Decimal, error) {
+	const api = "account_storage.GetOutflow"
+
+	query := `SELECT amount FROM outflow_counters WHERE account_id=$1 AND period=$2 AND period_start=$3`
+
+	var total decimal.Decimal
+	err := s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, accountID, period, periodStart).Scan(&total)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return decimal.Zero, nil
+		}
+		return decimal.Decimal{}, pkgerrors.Wrap(api, err)
+	}
+
+	return total, nil
+}
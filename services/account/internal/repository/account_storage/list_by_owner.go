@@ -0,0 +1,67 @@
+package account_storage
+
+import (
+	"context"
+	"fmt"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/google/uuid"
+	"strings"
+)
+
+type ListFilter struct {
+	Currency string
+	Status   string
+	Limit    int
+	Offset   int
+}
+
+// ListByOwner - owner's accounts page and total count of accounts matching filter
+func (s *AccountStorage) ListByOwner(ctx context.Context, ownerID uuid.UUID, filter ListFilter) ([]Account, int, error) {
+	const api = "account_storage.ListByOwner"
+
+	conditions := []string{"owner_id=$1"}
+	args := []interface{}{ownerID}
+
+	if filter.Currency != "" {
+		args = append(args, filter.Currency)
+		conditions = append(conditions, fmt.Sprintf("currency=$%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("status=$%d", len(args)))
+	}
+
+	where := strings.Join(conditions, " AND ")
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM account WHERE ` + where
+	if err := s.driver.GetQueryEngine(ctx).QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, pkgerrors.Wrap(api, err)
+	}
+
+	args = append(args, filter.Limit, filter.Offset)
+	query := fmt.Sprintf(selectAccount+`
+			  WHERE %s
+			  ORDER BY id
+			  LIMIT $%d OFFSET $%d`, where, len(args)-1, len(args))
+
+	rows, err := s.driver.GetQueryEngine(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, pkgerrors.Wrap(api, err)
+	}
+	defer rows.Close()
+
+	result := make([]Account, 0)
+	for rows.Next() {
+		acc, err := scanAccount(rows)
+		if err != nil {
+			return nil, 0, pkgerrors.Wrap(api, err)
+		}
+		result = append(result, acc)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, 0, pkgerrors.Wrap(api, err)
+	}
+
+	return result, total, nil
+}
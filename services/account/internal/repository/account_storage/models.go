@@ -2,17 +2,113 @@ package account_storage
 
 import (
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"time"
 )
 
 type Account struct {
-	ID        int64     `db:"id"`
-	OwnerID   uuid.UUID `db:"owner_id"`
-	Name      string    `db:"name"`
-	Currency  string    `db:"currency"`
-	Email     string    `db:"email"`
-	IsBlocked bool      `db:"is_blocked"`
-	Balance   float64   `db:"balance"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID       int64           `db:"id"`
+	Number   string          `db:"number"`
+	OwnerID  uuid.UUID       `db:"owner_id"`
+	Name     string          `db:"name"`
+	Currency string          `db:"currency"`
+	Product  string          `db:"product"`
+	Email    string          `db:"email"`
+	Status   string          `db:"status"`
+	Balance  decimal.Decimal `db:"balance"`
+	Held     decimal.Decimal `db:"held_amount"`
+	// OverdraftLimit - how far balance may go below zero, OverdraftRate - annual interest on negative balance
+	OverdraftLimit decimal.Decimal `db:"overdraft_limit"`
+	OverdraftRate  decimal.Decimal `db:"overdraft_rate"`
+	CreatedAt      time.Time       `db:"created_at"`
+	UpdatedAt      time.Time       `db:"updated_at"`
+}
+
+// Available - funds that can be spent: balance without active holds plus overdraft limit
+func (a Account) Available() decimal.Decimal {
+	return a.Balance.Sub(a.Held).Add(a.OverdraftLimit)
+}
+
+type StatusHistory struct {
+	ID         int64     `db:"id"`
+	AccountID  int64     `db:"account_id"`
+	FromStatus string    `db:"from_status"`
+	ToStatus   string    `db:"to_status"`
+	ReasonCode string    `db:"reason_code"`
+	Comment    string    `db:"comment"`
+	ChangedBy  string    `db:"changed_by"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+type Transaction struct {
+	ID             uuid.UUID        `db:"id"`
+	Type           string           `db:"type"`
+	IdempotencyKey *string          `db:"idempotency_key"`
+	FromAccountID  *int64           `db:"from_account_id"`
+	ToAccountID    *int64           `db:"to_account_id"`
+	FromNumber     string           `db:"from_number"`
+	ToNumber       string           `db:"to_number"`
+	DebitAmount    decimal.Decimal  `db:"debit_amount"`
+	DebitCurrency  string           `db:"debit_currency"`
+	CreditAmount   decimal.Decimal  `db:"credit_amount"`
+	CreditCurrency string           `db:"credit_currency"`
+	FXSnapshotID   *int64           `db:"fx_snapshot_id"`
+	FXQuoteID      *uuid.UUID       `db:"fx_quote_id"`
+	FXMidRate      *decimal.Decimal `db:"fx_mid_rate"`
+	FXSpread       *decimal.Decimal `db:"fx_spread"`
+	FXRate         *decimal.Decimal `db:"fx_rate"`
+	Description    string           `db:"description"`
+	InitiatedBy    string           `db:"initiated_by"`
+	CreatedAt      time.Time        `db:"created_at"`
+}
+
+type Entry struct {
+	ID            int64           `db:"id"`
+	AccountID     int64           `db:"account_id"`
+	TransactionID uuid.UUID       `db:"transaction_id"`
+	Type          string          `db:"type"`
+	Amount        decimal.Decimal `db:"amount"`
+	Currency      string          `db:"currency"`
+	BalanceAfter  decimal.Decimal `db:"balance_after"`
+	Counterparty  string          `db:"counterparty"`
+	Description   string          `db:"description"`
+	CreatedAt     time.Time       `db:"created_at"`
+}
+
+type Hold struct {
+	ID             uuid.UUID       `db:"id"`
+	AccountID      int64           `db:"account_id"`
+	AccountNumber  string          `db:"account_number"`
+	Amount         decimal.Decimal `db:"amount"`
+	CapturedAmount decimal.Decimal `db:"captured_amount"`
+	Currency       string          `db:"currency"`
+	Status         string          `db:"status"`
+	Reference      string          `db:"reference"`
+	Description    string          `db:"description"`
+	IdempotencyKey *string         `db:"idempotency_key"`
+	TransactionID  *uuid.UUID      `db:"transaction_id"`
+	CreatedBy      string          `db:"created_by"`
+	ExpiresAt      time.Time       `db:"expires_at"`
+	CreatedAt      time.Time       `db:"created_at"`
+	UpdatedAt      time.Time       `db:"updated_at"`
+}
+
+type InterestAccrual struct {
+	AccountID     int64           `db:"account_id"`
+	AccrualDate   time.Time       `db:"accrual_date"`
+	Kind          string          `db:"kind"`
+	Balance       decimal.Decimal `db:"balance"`
+	Rate          decimal.Decimal `db:"rate"`
+	Amount        decimal.Decimal `db:"amount"`
+	TransactionID *uuid.UUID      `db:"transaction_id"`
+	CreatedAt     time.Time       `db:"created_at"`
+}
+
+type Limits struct {
+	AccountID      int64            `db:"account_id"`
+	PerTransaction *decimal.Decimal `db:"per_transaction"`
+	Daily          *decimal.Decimal `db:"daily"`
+	Monthly        *decimal.Decimal `db:"monthly"`
+	UpdatedBy      string           `db:"updated_by"`
+	UpdatedAt      time.Time        `db:"updated_at"`
 }
@@ -0,0 +1,53 @@
+package account_storage
+
+import (
+	"context"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+func (s *AccountStorage) UpdateOverdraft(ctx context.Context, accountID int64, limit, rate decimal.Decimal) error {
+	const api = "account_storage.UpdateOverdraft"
+
+	query := `UPDATE account SET overdraft_limit=$1, overdraft_rate=$2, updated_at=$3 WHERE id=$4`
+
+	if _, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, limit, rate, time.Now(), accountID); err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+// ListOverdraftAccounts - page of not closed accounts with overdraft interest, ordered by id, ids > afterID
+func (s *AccountStorage) ListOverdraftAccounts(ctx context.Context, afterID int64, limit int) ([]Account, error) {
+	const api = "account_storage.ListOverdraftAccounts"
+
+	query := selectAccount + ` WHERE overdraft_rate > 0 AND status <> 'closed' AND id > $1 ORDER BY id LIMIT $2`
+
+	accounts, err := s.list(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return accounts, nil
+}
+
+func (s *AccountStorage) list(ctx context.Context, query string, args ...interface{}) ([]Account, error) {
+	rows, err := s.driver.GetQueryEngine(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]Account, 0)
+	for rows.Next() {
+		acc, err := scanAccount(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, acc)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,101 @@
+package account_storage
+
+import (
+	"account/internal/models"
+	"context"
+	"errors"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+const selectTransaction = `SELECT t.id, t.type, t.idempotency_key, t.from_account_id, t.to_account_id,
+			  COALESCE(fa.number, ''), COALESCE(ta.number, ''),
+			  t.debit_amount, t.debit_currency, t.credit_amount, t.credit_currency,
+			  t.fx_snapshot_id, t.fx_quote_id, t.fx_mid_rate, t.fx_spread, t.fx_rate,
+			  t.description, t.initiated_by, t.created_at
+			  FROM transactions t
+			  LEFT JOIN account fa ON fa.id = t.from_account_id
+			  LEFT JOIN account ta ON ta.id = t.to_account_id`
+
+// AddBalance - adds delta (may be negative) to account balance and returns new balance
+func (s *AccountStorage) AddBalance(ctx context.Context, accountID int64, delta decimal.Decimal) (decimal.Decimal, error) {
+	const api = "account_storage.AddBalance"
+
+	query := `UPDATE account SET balance=balance+$1, updated_at=$2 WHERE id=$3 RETURNING balance`
+
+	var balance decimal.Decimal
+	if err := s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, delta, time.Now(), accountID).Scan(&balance); err != nil {
+		return decimal.Decimal{}, pkgerrors.Wrap(api, err)
+	}
+
+	return balance, nil
+}
+
+func (s *AccountStorage) CreateTransaction(ctx context.Context, t *Transaction) error {
+	const api = "account_storage.CreateTransaction"
+
+	query := `INSERT INTO transactions (id, type, idempotency_key, from_account_id, to_account_id,
+			  debit_amount, debit_currency, credit_amount, credit_currency,
+			  fx_snapshot_id, fx_quote_id, fx_mid_rate, fx_spread, fx_rate,
+			  description, initiated_by, created_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)`
+
+	_, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, t.ID, t.Type, t.IdempotencyKey, t.FromAccountID, t.ToAccountID,
+		t.DebitAmount, t.DebitCurrency, t.CreditAmount, t.CreditCurrency,
+		t.FXSnapshotID, t.FXQuoteID, t.FXMidRate, t.FXSpread, t.FXRate,
+		t.Description, t.InitiatedBy, t.CreatedAt)
+	if err != nil {
+		var pgError *pgconn.PgError
+		if errors.As(err, &pgError) && pgError.Code == pgerrcode.UniqueViolation {
+			return pkgerrors.Wrap(api, models.ErrAlreadyExists)
+		}
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+func (s *AccountStorage) GetTransactionByIdempotencyKey(ctx context.Context, initiatedBy, key string) (Transaction, error) {
+	const api = "account_storage.GetTransactionByIdempotencyKey"
+	query := selectTransaction + ` WHERE t.initiated_by=$1 AND t.idempotency_key=$2`
+
+	t, err := scanTransaction(s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, initiatedBy, key))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Transaction{}, pkgerrors.Wrap(api, models.ErrNotFound)
+		}
+		return Transaction{}, pkgerrors.Wrap(api, err)
+	}
+
+	return t, nil
+}
+
+func scanTransaction(row pgx.Row) (Transaction, error) {
+	var t Transaction
+	err := row.Scan(
+		&t.ID,
+		&t.Type,
+		&t.IdempotencyKey,
+		&t.FromAccountID,
+		&t.ToAccountID,
+		&t.FromNumber,
+		&t.ToNumber,
+		&t.DebitAmount,
+		&t.DebitCurrency,
+		&t.CreditAmount,
+		&t.CreditCurrency,
+		&t.FXSnapshotID,
+		&t.FXQuoteID,
+		&t.FXMidRate,
+		&t.FXSpread,
+		&t.FXRate,
+		&t.Description,
+		&t.InitiatedBy,
+		&t.CreatedAt,
+	)
+	return t, err
+}
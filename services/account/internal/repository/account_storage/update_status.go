@@ -0,0 +1,33 @@
+package account_storage
+
+import (
+	"context"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"time"
+)
+
+func (s *AccountStorage) UpdateStatus(ctx context.Context, accountID int64, status string) error {
+	const api = "account_storage.UpdateStatus"
+
+	query := `UPDATE account SET status=$1, updated_at=$2 WHERE id=$3`
+
+	if _, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, status, time.Now(), accountID); err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+func (s *AccountStorage) AddStatusHistory(ctx context.Context, h *StatusHistory) error {
+	const api = "account_storage.AddStatusHistory"
+
+	query := `INSERT INTO account_status_history (account_id, from_status, to_status, reason_code, comment, changed_by, created_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`
+
+	err := s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, h.AccountID, h.FromStatus, h.ToStatus, h.ReasonCode, h.Comment, h.ChangedBy, h.CreatedAt).Scan(&h.ID)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package fx_storage
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+type Snapshot struct {
+	ID        int64     `db:"id"`
+	Source    string    `db:"source"`
+	ValidFrom time.Time `db:"valid_from"`
+	ValidTo   time.Time `db:"valid_to"`
+	CreatedBy string    `db:"created_by"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type Rate struct {
+	SnapshotID    int64           `db:"snapshot_id"`
+	BaseCurrency  string          `db:"base_currency"`
+	QuoteCurrency string          `db:"quote_currency"`
+	Rate          decimal.Decimal `db:"rate"`
+}
+
+type Quote struct {
+	ID            uuid.UUID       `db:"id"`
+	SnapshotID    int64           `db:"snapshot_id"`
+	BaseCurrency  string          `db:"base_currency"`
+	QuoteCurrency string          `db:"quote_currency"`
+	MidRate       decimal.Decimal `db:"mid_rate"`
+	Spread        decimal.Decimal `db:"spread"`
+	Rate          decimal.Decimal `db:"rate"`
+	CreatedAt     time.Time       `db:"created_at"`
+	ExpiresAt     time.Time       `db:"expires_at"`
+}
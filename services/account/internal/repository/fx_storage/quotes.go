@@ -0,0 +1,51 @@
+package fx_storage
+
+import (
+	"account/internal/models"
+	"context"
+	"errors"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *FXStorage) CreateQuote(ctx context.Context, q *Quote) error {
+	const api = "fx_storage.CreateQuote"
+
+	query := `INSERT INTO fx_quotes (id, snapshot_id, base_currency, quote_currency, mid_rate, spread, rate, created_at, expires_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, q.ID, q.SnapshotID, q.BaseCurrency, q.QuoteCurrency, q.MidRate, q.Spread, q.Rate, q.CreatedAt, q.ExpiresAt)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+func (s *FXStorage) GetQuote(ctx context.Context, id uuid.UUID) (Quote, error) {
+	const api = "fx_storage.GetQuote"
+
+	query := `SELECT id, snapshot_id, base_currency, quote_currency, mid_rate, spread, rate, created_at, expires_at FROM fx_quotes WHERE id=$1`
+
+	var q Quote
+	err := s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, id).Scan(
+		&q.ID,
+		&q.SnapshotID,
+		&q.BaseCurrency,
+		&q.QuoteCurrency,
+		&q.MidRate,
+		&q.Spread,
+		&q.Rate,
+		&q.CreatedAt,
+		&q.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Quote{}, pkgerrors.Wrap(api, models.ErrQuoteNotFound)
+		}
+		return Quote{}, pkgerrors.Wrap(api, err)
+	}
+
+	return q, nil
+}
@@ -0,0 +1,18 @@
+package fx_storage
+
+import (
+	"context"
+	"github.com/R1ckNash/Bank/pkg/transaction_manager"
+)
+
+type QueryEngineProvider interface {
+	GetQueryEngine(ctx context.Context) transaction_manager.QueryEngine
+}
+
+type FXStorage struct {
+	driver QueryEngineProvider
+}
+
+func New(driver QueryEngineProvider) *FXStorage {
+	return &FXStorage{driver: driver}
+}
@@ -0,0 +1,73 @@
+package fx_storage
+
+import (
+	"account/internal/models"
+	"context"
+	"errors"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+func (s *FXStorage) CreateSnapshot(ctx context.Context, snapshot *Snapshot, rates []Rate) error {
+	const api = "fx_storage.CreateSnapshot"
+
+	query := `INSERT INTO fx_rate_snapshots (source, valid_from, valid_to, created_by, created_at)
+			  VALUES ($1, $2, $3, $4, $5) RETURNING id`
+
+	engine := s.driver.GetQueryEngine(ctx)
+	err := engine.QueryRow(ctx, query, snapshot.Source, snapshot.ValidFrom, snapshot.ValidTo, snapshot.CreatedBy, snapshot.CreatedAt).Scan(&snapshot.ID)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	batch := &pgx.Batch{}
+	for _, rate := range rates {
+		batch.Queue(`INSERT INTO fx_rates (snapshot_id, base_currency, quote_currency, rate) VALUES ($1, $2, $3, $4)`,
+			snapshot.ID, rate.BaseCurrency, rate.QuoteCurrency, rate.Rate)
+	}
+
+	if err = engine.SendBatch(ctx, batch).Close(); err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+// GetRate - latest rate valid at moment for pair in any direction, caller inverts it if BaseCurrency != base
+func (s *FXStorage) GetRate(ctx context.Context, base, quote string, at time.Time) (Rate, error) {
+	const api = "fx_storage.GetRate"
+
+	query := `SELECT r.snapshot_id, r.base_currency, r.quote_currency, r.rate
+			  FROM fx_rates r
+			  JOIN fx_rate_snapshots s ON s.id = r.snapshot_id
+			  WHERE ((r.base_currency=$1 AND r.quote_currency=$2) OR (r.base_currency=$2 AND r.quote_currency=$1))
+			    AND s.valid_from <= $3 AND s.valid_to > $3
+			  ORDER BY s.valid_from DESC, s.id DESC, (r.base_currency=$1) DESC
+			  LIMIT 1`
+
+	var rate Rate
+	err := s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, base, quote, at).Scan(&rate.SnapshotID, &rate.BaseCurrency, &rate.QuoteCurrency, &rate.Rate)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Rate{}, pkgerrors.Wrap(api, models.ErrRateNotFound)
+		}
+		return Rate{}, pkgerrors.Wrap(api, err)
+	}
+
+	return rate, nil
+}
+
+// IsSnapshotValid - snapshot exists and is valid at moment
+func (s *FXStorage) IsSnapshotValid(ctx context.Context, snapshotID int64, at time.Time) (bool, error) {
+	const api = "fx_storage.IsSnapshotValid"
+
+	query := `SELECT EXISTS (SELECT 1 FROM fx_rate_snapshots WHERE id=$1 AND valid_from <= $2 AND valid_to > $2)`
+
+	var valid bool
+	if err := s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, snapshotID, at).Scan(&valid); err != nil {
+		return false, pkgerrors.Wrap(api, err)
+	}
+
+	return valid, nil
+}
@@ -4,20 +4,97 @@ import (
 	"account/internal/models"
 	"account/internal/repository/account_storage"
 	"context"
+	"github.com/R1ckNash/Bank/pkg/currency"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
 	"log/slog"
+	"time"
 )
 
 //go:generate mockery --name=AccountService --filename=account_service_mock.go --disable-version-string
 type AccountService interface {
 	RegisterAccount(ctx context.Context, account *models.Account) error
-	GetAccount(ctx context.Context, accountID int64) (models.Account, error)
+	GetAccount(ctx context.Context, number string) (models.Account, error)
+	ListAccounts(ctx context.Context, ownerID uuid.UUID, filter models.AccountFilter) ([]models.Account, int, error)
+	ChangeStatus(ctx context.Context, number string, change models.StatusChange) error
+	CloseAccount(ctx context.Context, number string, ownerID uuid.UUID) error
+	Transfer(ctx context.Context, req models.TransferRequest) (models.Transaction, error)
+	ListTransactions(ctx context.Context, number string, filter models.TransactionFilter) (models.TransactionPage, error)
+	GetStatement(ctx context.Context, number string, month time.Time) (models.Statement, error)
+	PlaceHold(ctx context.Context, req models.PlaceHoldRequest) (models.Hold, error)
+	CaptureHold(ctx context.Context, id uuid.UUID, amount *decimal.Decimal, capturedBy string) (models.Hold, error)
+	ReleaseHold(ctx context.Context, id uuid.UUID, releasedBy string) (models.Hold, error)
+	ReleaseExpiredHolds(ctx context.Context, limit int) (int, error)
+	ListHolds(ctx context.Context, number string, status models.HoldStatus) ([]models.Hold, error)
+	SetOverdraft(ctx context.Context, number string, settings models.OverdraftSettings) (models.Account, error)
+	AccrueOverdraftInterest(ctx context.Context, date time.Time) (int, error)
+	PostOverdraftInterest(ctx context.Context, before time.Time) (int, error)
+	AccrueInterest(ctx context.Context, date time.Time) (int, error)
+	PostInterest(ctx context.Context, before time.Time) (int, error)
+	SetLimits(ctx context.Context, number string, settings models.LimitsSettings) (models.AccountLimits, error)
+	GetLimits(ctx context.Context, number string) (models.AccountLimits, error)
 }
 
 //go:generate mockery --name=AccountStorage --filename=account_storage_mock.go --disable-version-string
 type AccountStorage interface {
 	CreateAccount(ctx context.Context, account *account_storage.Account) error
-	GetByID(ctx context.Context, accountID int64) (account_storage.Account, error)
+	GetByNumber(ctx context.Context, number string) (account_storage.Account, error)
+	GetByNumberForUpdate(ctx context.Context, number string) (account_storage.Account, error)
+	ListByOwner(ctx context.Context, ownerID uuid.UUID, filter account_storage.ListFilter) ([]account_storage.Account, int, error)
+	UpdateStatus(ctx context.Context, accountID int64, status string) error
+	AddStatusHistory(ctx context.Context, h *account_storage.StatusHistory) error
+	AddBalance(ctx context.Context, accountID int64, delta decimal.Decimal) (decimal.Decimal, error)
+	CreateTransaction(ctx context.Context, t *account_storage.Transaction) error
+	GetTransactionByIdempotencyKey(ctx context.Context, initiatedBy, key string) (account_storage.Transaction, error)
+	CreateEntry(ctx context.Context, e *account_storage.Entry) error
+	ListEntries(ctx context.Context, accountID int64, filter account_storage.EntryFilter) ([]account_storage.Entry, error)
+	ListEntriesForPeriod(ctx context.Context, accountID int64, from, to time.Time) ([]account_storage.Entry, error)
+	GetBalanceAt(ctx context.Context, accountID int64, at time.Time) (decimal.Decimal, error)
+	AddHeld(ctx context.Context, accountID int64, delta decimal.Decimal) error
+	CreateHold(ctx context.Context, h *account_storage.Hold) error
+	UpdateHold(ctx context.Context, h *account_storage.Hold) error
+	GetHold(ctx context.Context, id uuid.UUID) (account_storage.Hold, error)
+	GetHoldForUpdate(ctx context.Context, id uuid.UUID) (account_storage.Hold, error)
+	GetHoldByIdempotencyKey(ctx context.Context, createdBy, key string) (account_storage.Hold, error)
+	ListHolds(ctx context.Context, accountID int64, status string) ([]account_storage.Hold, error)
+	ListExpiredHoldIDs(ctx context.Context, at time.Time, limit int) ([]uuid.UUID, error)
+	UpdateOverdraft(ctx context.Context, accountID int64, limit, rate decimal.Decimal) error
+	ListOverdraftAccounts(ctx context.Context, afterID int64, limit int) ([]account_storage.Account, error)
+	ListProductAccounts(ctx context.Context, products []string, afterID int64, limit int) ([]account_storage.Account, error)
+	CreateAccrual(ctx context.Context, a *account_storage.InterestAccrual) (bool, error)
+	ListAccountsWithUnpostedAccruals(ctx context.Context, kind string, before time.Time) ([]string, error)
+	ListUnpostedAccrualsForUpdate(ctx context.Context, accountID int64, kind string, before time.Time) ([]account_storage.InterestAccrual, error)
+	GetInterestCarryForUpdate(ctx context.Context, accountID int64, kind string) (decimal.Decimal, error)
+	SetInterestCarry(ctx context.Context, accountID int64, kind string, amount decimal.Decimal) error
+	MarkAccrualsPosted(ctx context.Context, accountID int64, kind string, before time.Time, transactionID uuid.UUID) error
+	GetLimits(ctx context.Context, accountID int64) (account_storage.Limits, error)
+	UpsertLimits(ctx context.Context, l *account_storage.Limits) error
+	AddOutflow(ctx context.Context, accountID int64, period string, periodStart time.Time, amount decimal.Decimal) (decimal.Decimal, error)
+	GetOutflow(ctx context.Context, accountID int64, period string, periodStart time.Time) (decimal.Decimal, error)
+}
+
+//go:generate mockery --name=NumberGenerator
+type NumberGenerator interface {
+	Generate() (string, error)
+}
+
+//go:generate mockery --name=Currencies
+type Currencies interface {
+	Get(code string) (currency.Currency, error)
+}
+
+// FXQuoter - rate for cross-currency transfers
+//
+//go:generate mockery --name=FXQuoter
+type FXQuoter interface {
+	ResolveQuote(ctx context.Context, from, to string, quoteID *uuid.UUID) (models.FXQuote, error)
+}
+
+//go:generate mockery --name=Products
+type Products interface {
+	Get(code string) (models.Product, error)
+	InterestBearing() []string
 }
 
 //go:generate mockery --name=EventProducer
@@ -28,13 +105,22 @@ type EventProducer interface {
 // TransactionManager trx manager
 type TransactionManager interface {
 	RunReadCommitted(ctx context.Context, accessMode pgx.TxAccessMode, f func(ctx context.Context) error) error
+	RunSerializable(ctx context.Context, accessMode pgx.TxAccessMode, f func(ctx context.Context) error) error
 }
 
 type Deps struct {
 	AccountStorage
 	TransactionManager
 	EventProducer
+	NumberGenerator
+	Currencies
+	Products
+	FXQuoter
 	Logger *slog.Logger
+
+	// HoldDefaultTTL - expiry of hold placed without explicit expiry, HoldMaxTTL - the longest allowed one
+	HoldDefaultTTL time.Duration
+	HoldMaxTTL     time.Duration
 }
 
 type accountService struct {
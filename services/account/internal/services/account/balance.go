@@ -0,0 +1,59 @@
+package account
+
+import (
+	"account/internal/repository/account_storage"
+	slog_helper "account/internal/slog"
+	"context"
+	"encoding/json"
+	"github.com/R1ckNash/Bank/pkg/events"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"time"
+)
+
+// addBalance - changes balance of locked account, returns new balance and overdraft event if balance crossed zero
+func (s *accountService) addBalance(ctx context.Context, acc account_storage.Account, delta decimal.Decimal) (decimal.Decimal, *events.AccountOverdraft, error) {
+	balance, err := s.AccountStorage.AddBalance(ctx, acc.ID, delta)
+	if err != nil {
+		return decimal.Decimal{}, nil, err
+	}
+
+	var eventType string
+	switch {
+	case !acc.Balance.IsNegative() && balance.IsNegative():
+		eventType = events.OverdraftEntered
+	case acc.Balance.IsNegative() && !balance.IsNegative():
+		eventType = events.OverdraftLeft
+	default:
+		return balance, nil, nil
+	}
+
+	return balance, &events.AccountOverdraft{
+		EventType:      eventType,
+		AccountNumber:  acc.Number,
+		OwnerID:        acc.OwnerID,
+		Currency:       acc.Currency,
+		Balance:        s.formatAmount(acc.Currency, balance),
+		OverdraftLimit: s.formatAmount(acc.Currency, acc.OverdraftLimit),
+		OccurredAt:     time.Now(),
+	}, nil
+}
+
+// sendOverdraftEvents - must be called after commit, nil events are skipped
+func (s *accountService) sendOverdraftEvents(log *slog.Logger, overdraftEvents ...*events.AccountOverdraft) {
+	for _, event := range overdraftEvents {
+		if event == nil {
+			continue
+		}
+
+		eventJson, err := json.Marshal(event)
+		if err != nil {
+			log.Error("could not marshall overdraft event", slog_helper.Err(err))
+			continue
+		}
+
+		if err = s.EventProducer.SendMessage(events.TopicAccountOverdraft, event.AccountNumber, eventJson); err != nil {
+			log.Error("could not send overdraft event", slog_helper.Err(err))
+		}
+	}
+}
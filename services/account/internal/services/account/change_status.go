@@ -0,0 +1,142 @@
+package account
+
+import (
+	"account/internal/models"
+	"account/internal/repository/account_storage"
+	slog_helper "account/internal/slog"
+	"context"
+	"encoding/json"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/R1ckNash/Bank/pkg/events"
+	"github.com/R1ckNash/Bank/pkg/transaction_manager"
+	"github.com/google/uuid"
+	"log/slog"
+	"time"
+)
+
+var actionEvents = map[models.StatusAction]string{
+	models.ActionFreeze:   events.AccountFrozen,
+	models.ActionUnfreeze: events.AccountUnfrozen,
+	models.ActionBlock:    events.AccountBlocked,
+	models.ActionUnblock:  events.AccountUnblocked,
+	models.ActionClose:    events.AccountClosed,
+}
+
+// ChangeStatus - operator moves account through the lifecycle (block, unblock, freeze, unfreeze)
+func (s *accountService) ChangeStatus(ctx context.Context, number string, change models.StatusChange) error {
+	const op = "accountService.ChangeStatus"
+
+	if !change.ReasonCode.IsValid() {
+		return pkgerrors.Wrap(op, models.ErrInvalidReasonCode)
+	}
+
+	// closure is initiated only by owner, see CloseAccount
+	if change.Action == models.ActionClose {
+		return pkgerrors.Wrap(op, models.ErrInvalidTransition)
+	}
+
+	return pkgerrors.Wrap(op, s.changeStatus(ctx, number, change, nil))
+}
+
+// CloseAccount - owner-initiated closure, allowed only with zero balance and no active holds
+func (s *accountService) CloseAccount(ctx context.Context, number string, ownerID uuid.UUID) error {
+	const op = "accountService.CloseAccount"
+
+	change := models.StatusChange{
+		Action:     models.ActionClose,
+		ReasonCode: models.ReasonOwnerClosure,
+		ChangedBy:  ownerID.String(),
+	}
+
+	err := s.changeStatus(ctx, number, change, func(acc account_storage.Account) error {
+		// accounts of other owners are not revealed
+		if acc.OwnerID != ownerID {
+			return models.ErrNotFound
+		}
+		if !acc.Balance.IsZero() || !acc.Held.IsZero() {
+			return models.ErrNonZeroBalance
+		}
+		return nil
+	})
+
+	return pkgerrors.Wrap(op, err)
+}
+
+// changeStatus - applies change under row lock, check is called with locked account before transition
+func (s *accountService) changeStatus(ctx context.Context, number string, change models.StatusChange, check func(acc account_storage.Account) error) error {
+	log := s.Logger.With(
+		slog.String("op", "accountService.changeStatus"),
+		slog.String("number", number),
+		slog.String("action", string(change.Action)),
+	)
+
+	log.Info("Processing request for account status change", slog.String("reason_code", string(change.ReasonCode)))
+
+	var event events.AccountStatusChanged
+	err := s.TransactionManager.RunReadCommitted(ctx, transaction_manager.ReadWrite,
+		func(txCtx context.Context) error { // TRANSANCTION SCOPE
+			acc, err := s.AccountStorage.GetByNumberForUpdate(txCtx, number)
+			if err != nil {
+				return err
+			}
+
+			if check != nil {
+				if err = check(acc); err != nil {
+					return err
+				}
+			}
+
+			from := models.AccountStatus(acc.Status)
+			to, err := from.Apply(change.Action)
+			if err != nil {
+				return err
+			}
+
+			if err = s.AccountStorage.UpdateStatus(txCtx, acc.ID, string(to)); err != nil {
+				return err
+			}
+
+			now := time.Now()
+			err = s.AccountStorage.AddStatusHistory(txCtx, &account_storage.StatusHistory{
+				AccountID:  acc.ID,
+				FromStatus: string(from),
+				ToStatus:   string(to),
+				ReasonCode: string(change.ReasonCode),
+				Comment:    change.Comment,
+				ChangedBy:  change.ChangedBy,
+				CreatedAt:  now,
+			})
+			if err != nil {
+				return err
+			}
+
+			event = events.AccountStatusChanged{
+				EventType:     actionEvents[change.Action],
+				AccountNumber: number,
+				OwnerID:       acc.OwnerID,
+				FromStatus:    string(from),
+				ToStatus:      string(to),
+				ReasonCode:    string(change.ReasonCode),
+				ChangedBy:     change.ChangedBy,
+				ChangedAt:     now,
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		log.Warn("error changing account status", slog_helper.Err(err))
+		return err
+	}
+
+	eventJson, err := json.Marshal(event)
+	if err != nil {
+		log.Error("could not marshall status changed event", slog_helper.Err(err))
+		return err
+	}
+
+	if err = s.EventProducer.SendMessage(events.TopicAccountStatusChanged, number, eventJson); err != nil {
+		log.Error("could not send status changed event", slog_helper.Err(err))
+	}
+
+	return nil
+}
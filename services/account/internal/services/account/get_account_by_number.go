@@ -0,0 +1,58 @@
+package account
+
+import (
+	"account/internal/models"
+	"account/internal/repository/account_storage"
+	slog_helper "account/internal/slog"
+	"context"
+	"fmt"
+	"github.com/R1ckNash/Bank/pkg/currency"
+	"github.com/shopspring/decimal"
+	"log/slog"
+)
+
+func (s *accountService) GetAccount(ctx context.Context, number string) (models.Account, error) {
+	const op = "accountService.GetAccount"
+
+	log := s.Logger.With(
+		slog.String("op", op),
+	)
+
+	log.Info("Processing request for get account", slog.String("number", number))
+
+	accDB, err := s.AccountStorage.GetByNumber(ctx, number)
+	if err != nil {
+		log.Error("failed to retrieve accDB by number", slog_helper.Err(err))
+		return models.Account{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s.toAccountModel(accDB), nil
+}
+
+func (s *accountService) toAccountModel(accDB account_storage.Account) models.Account {
+	return models.Account{
+		ID:               accDB.ID,
+		Number:           accDB.Number,
+		OwnerID:          accDB.OwnerID,
+		Name:             accDB.Name,
+		Currency:         accDB.Currency,
+		Product:          accDB.Product,
+		Balance:          s.formatAmount(accDB.Currency, accDB.Balance),
+		AvailableBalance: s.formatAmount(accDB.Currency, accDB.Available()),
+		OverdraftLimit:   s.formatAmount(accDB.Currency, accDB.OverdraftLimit),
+		Email:            accDB.Email,
+		Status:           accDB.Status,
+	}
+}
+
+// formatAmount - amount with minor units of currency, currency may be not supported anymore
+func (s *accountService) formatAmount(code string, amount decimal.Decimal) string {
+	cur, err := s.Currencies.Get(code)
+	if err != nil {
+		if cur, err = currency.Lookup(code); err != nil {
+			return amount.String()
+		}
+	}
+
+	return cur.Format(amount)
+}
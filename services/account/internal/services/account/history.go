@@ -0,0 +1,171 @@
+package account
+
+import (
+	"account/internal/models"
+	"account/internal/repository/account_storage"
+	slog_helper "account/internal/slog"
+	"context"
+	"encoding/base64"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"strconv"
+	"time"
+)
+
+// ListTransactions - account history page with running balance, newest first
+func (s *accountService) ListTransactions(ctx context.Context, number string, filter models.TransactionFilter) (models.TransactionPage, error) {
+	const op = "accountService.ListTransactions"
+
+	log := s.Logger.With(
+		slog.String("op", op),
+		slog.String("number", number),
+	)
+
+	log.Info("Processing request for list transactions")
+
+	if filter.Limit <= 0 {
+		filter.Limit = models.ListLimitDefault
+	}
+	if filter.Limit > models.ListLimitMax {
+		filter.Limit = models.ListLimitMax
+	}
+
+	beforeID, err := decodeCursor(filter.Cursor)
+	if err != nil {
+		return models.TransactionPage{}, pkgerrors.Wrap(op, err)
+	}
+
+	acc, err := s.AccountStorage.GetByNumber(ctx, number)
+	if err != nil {
+		log.Warn("failed to retrieve account", slog_helper.Err(err))
+		return models.TransactionPage{}, pkgerrors.Wrap(op, err)
+	}
+
+	types := make([]string, 0, len(filter.Types))
+	for _, t := range filter.Types {
+		types = append(types, string(t))
+	}
+
+	// one extra entry tells if there is next page
+	entriesDB, err := s.AccountStorage.ListEntries(ctx, acc.ID, account_storage.EntryFilter{
+		BeforeID: beforeID,
+		From:     filter.From,
+		To:       filter.To,
+		Types:    types,
+		Limit:    filter.Limit + 1,
+	})
+	if err != nil {
+		log.Error("failed to list entries", slog_helper.Err(err))
+		return models.TransactionPage{}, pkgerrors.Wrap(op, err)
+	}
+
+	page := models.TransactionPage{Entries: make([]models.Entry, 0, len(entriesDB))}
+	if len(entriesDB) > filter.Limit {
+		entriesDB = entriesDB[:filter.Limit]
+		page.NextCursor = encodeCursor(entriesDB[len(entriesDB)-1].ID)
+	}
+
+	for _, e := range entriesDB {
+		page.Entries = append(page.Entries, s.toEntryModel(e))
+	}
+
+	return page, nil
+}
+
+// GetStatement - statement for calendar month (UTC) of month
+func (s *accountService) GetStatement(ctx context.Context, number string, month time.Time) (models.Statement, error) {
+	const op = "accountService.GetStatement"
+
+	log := s.Logger.With(
+		slog.String("op", op),
+		slog.String("number", number),
+	)
+
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+
+	log.Info("Processing request for statement", slog.String("month", start.Format(models.StatementMonthLayout)))
+
+	acc, err := s.AccountStorage.GetByNumber(ctx, number)
+	if err != nil {
+		log.Warn("failed to retrieve account", slog_helper.Err(err))
+		return models.Statement{}, pkgerrors.Wrap(op, err)
+	}
+
+	opening, err := s.AccountStorage.GetBalanceAt(ctx, acc.ID, start)
+	if err != nil {
+		log.Error("failed to get opening balance", slog_helper.Err(err))
+		return models.Statement{}, pkgerrors.Wrap(op, err)
+	}
+
+	entriesDB, err := s.AccountStorage.ListEntriesForPeriod(ctx, acc.ID, start, end)
+	if err != nil {
+		log.Error("failed to list entries", slog_helper.Err(err))
+		return models.Statement{}, pkgerrors.Wrap(op, err)
+	}
+
+	closing := opening
+	credits, debits := decimal.Zero, decimal.Zero
+	entries := make([]models.Entry, 0, len(entriesDB))
+	for _, e := range entriesDB {
+		if e.Amount.IsNegative() {
+			debits = debits.Add(e.Amount.Neg())
+		} else {
+			credits = credits.Add(e.Amount)
+		}
+		closing = e.BalanceAfter
+		entries = append(entries, s.toEntryModel(e))
+	}
+
+	return models.Statement{
+		AccountNumber:  acc.Number,
+		AccountName:    acc.Name,
+		Currency:       acc.Currency,
+		Month:          start.Format(models.StatementMonthLayout),
+		PeriodStart:    start,
+		PeriodEnd:      end,
+		OpeningBalance: s.formatAmount(acc.Currency, opening),
+		ClosingBalance: s.formatAmount(acc.Currency, closing),
+		TotalCredits:   s.formatAmount(acc.Currency, credits),
+		TotalDebits:    s.formatAmount(acc.Currency, debits),
+		Entries:        entries,
+		GeneratedAt:    time.Now().UTC(),
+	}, nil
+}
+
+func (s *accountService) toEntryModel(e account_storage.Entry) models.Entry {
+	return models.Entry{
+		TransactionID: e.TransactionID,
+		Type:          models.TransactionType(e.Type),
+		Amount:        s.formatAmount(e.Currency, e.Amount),
+		Currency:      e.Currency,
+		BalanceAfter:  s.formatAmount(e.Currency, e.BalanceAfter),
+		Counterparty:  e.Counterparty,
+		Description:   e.Description,
+		CreatedAt:     e.CreatedAt,
+	}
+}
+
+// cursor is opaque for clients: base64 of last returned entry id
+func encodeCursor(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+func decodeCursor(cursor string) (int64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, models.ErrInvalidCursor
+	}
+
+	id, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, models.ErrInvalidCursor
+	}
+
+	return id, nil
+}
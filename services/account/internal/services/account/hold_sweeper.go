@@ -0,0 +1,63 @@
+package account
+
+import (
+	slog_helper "account/internal/slog"
+	"context"
+	"log/slog"
+	"time"
+)
+
+// ExpiredHoldsReleaser - releases holds past their expiry
+type ExpiredHoldsReleaser interface {
+	ReleaseExpiredHolds(ctx context.Context, limit int) (int, error)
+}
+
+// HoldSweeper - periodically expires stale holds, safe to run on every instance
+type HoldSweeper struct {
+	releaser  ExpiredHoldsReleaser
+	logger    *slog.Logger
+	interval  time.Duration
+	batchSize int
+}
+
+func NewHoldSweeper(releaser ExpiredHoldsReleaser, logger *slog.Logger, interval time.Duration, batchSize int) *HoldSweeper {
+	return &HoldSweeper{
+		releaser:  releaser,
+		logger:    logger,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+func (s *HoldSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	s.logger.Info("hold sweeper started", slog.Duration("interval", s.interval))
+
+	for {
+		select {
+		case <-ticker.C:
+			s.sweep(ctx)
+		case <-ctx.Done():
+			s.logger.Info("hold sweeper stopped")
+			return
+		}
+	}
+}
+
+// sweep - releases batches until there are no expired holds left
+func (s *HoldSweeper) sweep(ctx context.Context) {
+	for ctx.Err() == nil {
+		released, err := s.releaser.ReleaseExpiredHolds(ctx, s.batchSize)
+		if err != nil {
+			s.logger.Error("failed to release expired holds", slog_helper.Err(err))
+			return
+		}
+		if released > 0 {
+			s.logger.Info("expired holds released", slog.Int("count", released))
+		}
+		if released < s.batchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,341 @@
+package account
+
+import (
+	"account/internal/models"
+	"account/internal/repository/account_storage"
+	slog_helper "account/internal/slog"
+	"context"
+	"errors"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/R1ckNash/Bank/pkg/events"
+	"github.com/R1ckNash/Bank/pkg/transaction_manager"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"time"
+)
+
+// PlaceHold - reserves funds on active account, they stay in ledger balance but not in available one
+func (s *accountService) PlaceHold(ctx context.Context, req models.PlaceHoldRequest) (models.Hold, error) {
+	const op = "accountService.PlaceHold"
+
+	log := s.Logger.With(
+		slog.String("op", op),
+		slog.String("number", req.Number),
+		slog.String("idempotency_key", req.IdempotencyKey),
+	)
+
+	log.Info("Processing request for place hold", slog.String("amount", req.Amount.String()))
+
+	if !req.Amount.IsPositive() {
+		return models.Hold{}, pkgerrors.Wrap(op, models.ErrInvalidAmount)
+	}
+
+	now := time.Now()
+	if req.ExpiresAt.IsZero() {
+		req.ExpiresAt = now.Add(s.HoldDefaultTTL)
+	}
+	if !req.ExpiresAt.After(now) || req.ExpiresAt.After(now.Add(s.HoldMaxTTL)) {
+		return models.Hold{}, pkgerrors.Wrap(op, models.ErrInvalidExpiry)
+	}
+
+	if req.IdempotencyKey != "" {
+		existing, err := s.AccountStorage.GetHoldByIdempotencyKey(ctx, req.CreatedBy, req.IdempotencyKey)
+		if err == nil {
+			return s.replayHold(existing, req)
+		}
+		if !errors.Is(err, models.ErrHoldNotFound) {
+			log.Error("failed to check idempotency key", slog_helper.Err(err))
+			return models.Hold{}, pkgerrors.Wrap(op, err)
+		}
+	}
+
+	var hold account_storage.Hold
+	err := s.TransactionManager.RunSerializable(ctx, transaction_manager.ReadWrite,
+		func(txCtx context.Context) error { // TRANSANCTION SCOPE
+			acc, err := s.AccountStorage.GetByNumberForUpdate(txCtx, req.Number)
+			if err != nil {
+				return err
+			}
+
+			if acc.Status != string(models.StatusActive) {
+				return models.ErrAccountNotActive
+			}
+
+			cur, err := s.Currencies.Get(acc.Currency)
+			if err != nil {
+				return models.ErrUnsupportedCurrency
+			}
+			if !cur.Round(req.Amount).Equal(req.Amount) {
+				return models.ErrInvalidAmount
+			}
+			if acc.Available().LessThan(req.Amount) {
+				return models.ErrInsufficientFunds
+			}
+
+			hold = account_storage.Hold{
+				ID:             uuid.New(),
+				AccountID:      acc.ID,
+				AccountNumber:  acc.Number,
+				Amount:         req.Amount,
+				CapturedAmount: decimal.Zero,
+				Currency:       cur.Code,
+				Status:         string(models.HoldActive),
+				Reference:      req.Reference,
+				Description:    req.Description,
+				CreatedBy:      req.CreatedBy,
+				ExpiresAt:      req.ExpiresAt,
+				CreatedAt:      now,
+				UpdatedAt:      now,
+			}
+			if req.IdempotencyKey != "" {
+				hold.IdempotencyKey = &req.IdempotencyKey
+			}
+
+			if err = s.AccountStorage.AddHeld(txCtx, acc.ID, req.Amount); err != nil {
+				return err
+			}
+
+			return s.AccountStorage.CreateHold(txCtx, &hold)
+		},
+	)
+	if err != nil {
+		// concurrent request with same key won the race, its row may not be on replica yet
+		if req.IdempotencyKey != "" && errors.Is(err, models.ErrAlreadyExists) {
+			existing, getErr := s.AccountStorage.GetHoldByIdempotencyKey(transaction_manager.WithPrimary(ctx), req.CreatedBy, req.IdempotencyKey)
+			if getErr == nil {
+				return s.replayHold(existing, req)
+			}
+		}
+
+		log.Warn("error placing hold", slog_helper.Err(err))
+		return models.Hold{}, pkgerrors.Wrap(op, err)
+	}
+
+	return s.toHoldModel(hold), nil
+}
+
+// replayHold - hold placed by the first request with the same key, the key can't be reused for another hold
+func (s *accountService) replayHold(existing account_storage.Hold, req models.PlaceHoldRequest) (models.Hold, error) {
+	const op = "accountService.replayHold"
+
+	if existing.AccountNumber != req.Number || !existing.Amount.Equal(req.Amount) || existing.Reference != req.Reference {
+		return models.Hold{}, pkgerrors.Wrap(op, models.ErrIdempotencyMismatch)
+	}
+
+	return s.toHoldModel(existing), nil
+}
+
+// CaptureHold - settles hold: debits amount (full hold if nil) from account, remainder of partial capture is released
+func (s *accountService) CaptureHold(ctx context.Context, id uuid.UUID, amount *decimal.Decimal, capturedBy string) (models.Hold, error) {
+	const op = "accountService.CaptureHold"
+
+	log := s.Logger.With(
+		slog.String("op", op),
+		slog.String("hold_id", id.String()),
+	)
+
+	log.Info("Processing request for capture hold")
+
+	var overdraftEvent *events.AccountOverdraft
+	hold, err := s.updateHold(ctx, id, func(txCtx context.Context, acc account_storage.Account, hold *account_storage.Hold) error {
+		capture := hold.Amount
+		if amount != nil {
+			capture = *amount
+		}
+
+		cur, err := s.Currencies.Get(hold.Currency)
+		if err != nil {
+			return models.ErrUnsupportedCurrency
+		}
+		if !capture.IsPositive() || capture.GreaterThan(hold.Amount) || !cur.Round(capture).Equal(capture) {
+			return models.ErrInvalidAmount
+		}
+		// captured amount leaves account like transfer does, so it counts towards the same limits
+		if err = s.checkLimits(txCtx, acc, capture, time.Now()); err != nil {
+			return err
+		}
+
+		if err = s.AccountStorage.AddHeld(txCtx, acc.ID, hold.Amount.Neg()); err != nil {
+			return err
+		}
+		balance, overdraft, err := s.addBalance(txCtx, acc, capture.Neg())
+		if err != nil {
+			return err
+		}
+		overdraftEvent = overdraft
+
+		trx := account_storage.Transaction{
+			ID:             uuid.New(),
+			Type:           string(models.TransactionHoldCapture),
+			FromAccountID:  &acc.ID,
+			FromNumber:     acc.Number,
+			DebitAmount:    capture,
+			DebitCurrency:  hold.Currency,
+			CreditAmount:   capture,
+			CreditCurrency: hold.Currency,
+			Description:    holdDescription(*hold),
+			InitiatedBy:    capturedBy,
+			CreatedAt:      time.Now(),
+		}
+		if err = s.AccountStorage.CreateTransaction(txCtx, &trx); err != nil {
+			return err
+		}
+
+		err = s.AccountStorage.CreateEntry(txCtx, &account_storage.Entry{
+			AccountID:     acc.ID,
+			TransactionID: trx.ID,
+			Type:          trx.Type,
+			Amount:        capture.Neg(),
+			Currency:      hold.Currency,
+			BalanceAfter:  balance,
+			Description:   trx.Description,
+			CreatedAt:     trx.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		hold.Status = string(models.HoldCaptured)
+		hold.CapturedAmount = capture
+		hold.TransactionID = &trx.ID
+		return nil
+	})
+	if err != nil {
+		log.Warn("error capturing hold", slog_helper.Err(err))
+		return models.Hold{}, pkgerrors.Wrap(op, err)
+	}
+
+	s.sendOverdraftEvents(log, overdraftEvent)
+
+	return s.toHoldModel(hold), nil
+}
+
+// ReleaseHold - cancels hold and returns reserved funds to available balance
+func (s *accountService) ReleaseHold(ctx context.Context, id uuid.UUID, releasedBy string) (models.Hold, error) {
+	const op = "accountService.ReleaseHold"
+
+	log := s.Logger.With(
+		slog.String("op", op),
+		slog.String("hold_id", id.String()),
+		slog.String("released_by", releasedBy),
+	)
+
+	log.Info("Processing request for release hold")
+
+	hold, err := s.updateHold(ctx, id, func(txCtx context.Context, acc account_storage.Account, hold *account_storage.Hold) error {
+		hold.Status = string(models.HoldReleased)
+		return s.AccountStorage.AddHeld(txCtx, acc.ID, hold.Amount.Neg())
+	})
+	if err != nil {
+		log.Warn("error releasing hold", slog_helper.Err(err))
+		return models.Hold{}, pkgerrors.Wrap(op, err)
+	}
+
+	return s.toHoldModel(hold), nil
+}
+
+// ReleaseExpiredHolds - expires up to limit active holds past their expiry, returns number of expired holds
+func (s *accountService) ReleaseExpiredHolds(ctx context.Context, limit int) (int, error) {
+	const op = "accountService.ReleaseExpiredHolds"
+
+	ids, err := s.AccountStorage.ListExpiredHoldIDs(ctx, time.Now(), limit)
+	if err != nil {
+		return 0, pkgerrors.Wrap(op, err)
+	}
+
+	expired := 0
+	for _, id := range ids {
+		_, err = s.updateHold(ctx, id, func(txCtx context.Context, acc account_storage.Account, hold *account_storage.Hold) error {
+			hold.Status = string(models.HoldExpired)
+			return s.AccountStorage.AddHeld(txCtx, acc.ID, hold.Amount.Neg())
+		})
+		if err != nil {
+			// hold could be captured or released concurrently
+			if errors.Is(err, models.ErrHoldNotActive) {
+				continue
+			}
+			return expired, pkgerrors.Wrap(op, err)
+		}
+		expired++
+	}
+
+	return expired, nil
+}
+
+func (s *accountService) ListHolds(ctx context.Context, number string, status models.HoldStatus) ([]models.Hold, error) {
+	const op = "accountService.ListHolds"
+
+	acc, err := s.AccountStorage.GetByNumber(ctx, number)
+	if err != nil {
+		return nil, pkgerrors.Wrap(op, err)
+	}
+
+	holdsDB, err := s.AccountStorage.ListHolds(ctx, acc.ID, string(status))
+	if err != nil {
+		return nil, pkgerrors.Wrap(op, err)
+	}
+
+	holds := make([]models.Hold, 0, len(holdsDB))
+	for _, h := range holdsDB {
+		holds = append(holds, s.toHoldModel(h))
+	}
+
+	return holds, nil
+}
+
+// updateHold - runs change for active hold with account and hold rows locked (in this order) and saves hold
+func (s *accountService) updateHold(ctx context.Context, id uuid.UUID, change func(txCtx context.Context, acc account_storage.Account, hold *account_storage.Hold) error) (account_storage.Hold, error) {
+	hold, err := s.AccountStorage.GetHold(ctx, id)
+	if err != nil {
+		return account_storage.Hold{}, err
+	}
+
+	err = s.TransactionManager.RunSerializable(ctx, transaction_manager.ReadWrite,
+		func(txCtx context.Context) error { // TRANSANCTION SCOPE
+			acc, err := s.AccountStorage.GetByNumberForUpdate(txCtx, hold.AccountNumber)
+			if err != nil {
+				return err
+			}
+
+			if hold, err = s.AccountStorage.GetHoldForUpdate(txCtx, id); err != nil {
+				return err
+			}
+			if hold.Status != string(models.HoldActive) {
+				return models.ErrHoldNotActive
+			}
+
+			if err = change(txCtx, acc, &hold); err != nil {
+				return err
+			}
+
+			hold.UpdatedAt = time.Now()
+			return s.AccountStorage.UpdateHold(txCtx, &hold)
+		},
+	)
+
+	return hold, err
+}
+
+func holdDescription(h account_storage.Hold) string {
+	if h.Description != "" {
+		return h.Description
+	}
+	return h.Reference
+}
+
+func (s *accountService) toHoldModel(h account_storage.Hold) models.Hold {
+	return models.Hold{
+		ID:             h.ID,
+		AccountNumber:  h.AccountNumber,
+		Amount:         s.formatAmount(h.Currency, h.Amount),
+		CapturedAmount: s.formatAmount(h.Currency, h.CapturedAmount),
+		Currency:       h.Currency,
+		Status:         models.HoldStatus(h.Status),
+		Reference:      h.Reference,
+		Description:    h.Description,
+		TransactionID:  h.TransactionID,
+		ExpiresAt:      h.ExpiresAt,
+		CreatedAt:      h.CreatedAt,
+	}
+}
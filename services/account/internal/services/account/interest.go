@@ -0,0 +1,215 @@
+package account
+
+import (
+	"account/internal/models"
+	"account/internal/repository/account_storage"
+	slog_helper "account/internal/slog"
+	"context"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/R1ckNash/Bank/pkg/events"
+	"github.com/R1ckNash/Bank/pkg/transaction_manager"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"time"
+)
+
+const (
+	daysInYear = 365
+	// accrualPrecision - decimals of daily accrual, posted amount is truncated to currency minor units
+	accrualPrecision = 8
+	// accrualPageSize - accounts processed by one query during accrual
+	accrualPageSize = 500
+)
+
+// AccrueInterest - daily interest of interest-bearing products on end-of-day positive balance of date (UTC),
+// idempotent per account and date
+func (s *accountService) AccrueInterest(ctx context.Context, date time.Time) (int, error) {
+	const op = "accountService.AccrueInterest"
+
+	products := s.Products.InterestBearing()
+	if len(products) == 0 {
+		return 0, nil
+	}
+
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	endOfDay := day.AddDate(0, 0, 1)
+
+	accrued := 0
+	var afterID int64
+	for {
+		accounts, err := s.AccountStorage.ListProductAccounts(ctx, products, afterID, accrualPageSize)
+		if err != nil {
+			return accrued, pkgerrors.Wrap(op, err)
+		}
+
+		for _, acc := range accounts {
+			product, err := s.Products.Get(acc.Product)
+			if err != nil {
+				return accrued, pkgerrors.Wrap(op, err)
+			}
+
+			// exact ledger balance, not rounded to minor units
+			balance, err := s.AccountStorage.GetBalanceAt(ctx, acc.ID, endOfDay)
+			if err != nil {
+				return accrued, pkgerrors.Wrap(op, err)
+			}
+			if !balance.IsPositive() {
+				continue
+			}
+
+			created, err := s.AccountStorage.CreateAccrual(ctx, &account_storage.InterestAccrual{
+				AccountID:   acc.ID,
+				AccrualDate: day,
+				Kind:        models.InterestKindCredit,
+				Balance:     balance,
+				Rate:        product.InterestRate,
+				Amount:      dailyInterest(balance, product.InterestRate),
+				CreatedAt:   time.Now(),
+			})
+			if err != nil {
+				return accrued, pkgerrors.Wrap(op, err)
+			}
+			if created {
+				accrued++
+			}
+		}
+
+		if len(accounts) < accrualPageSize {
+			return accrued, nil
+		}
+		afterID = accounts[len(accounts)-1].ID
+	}
+}
+
+// PostInterest - capitalizes interest accrued before date, one transaction per account
+func (s *accountService) PostInterest(ctx context.Context, before time.Time) (int, error) {
+	const op = "accountService.PostInterest"
+
+	posted, err := s.postAccruals(ctx, models.InterestKindCredit, before)
+	return posted, pkgerrors.Wrap(op, err)
+}
+
+// postAccruals - posts not posted accruals of kind before date: credit interest is paid to account,
+// overdraft interest is charged; accruals are linked with posting transaction so reruns are safe
+func (s *accountService) postAccruals(ctx context.Context, kind string, before time.Time) (int, error) {
+	log := s.Logger.With(
+		slog.String("op", "accountService.postAccruals"),
+		slog.String("kind", kind),
+	)
+
+	trxType, description := models.TransactionInterest, "Interest"
+	if kind == models.InterestKindOverdraft {
+		trxType, description = models.TransactionOverdraftInterest, "Overdraft interest"
+	}
+
+	numbers, err := s.AccountStorage.ListAccountsWithUnpostedAccruals(ctx, kind, before)
+	if err != nil {
+		return 0, err
+	}
+
+	posted := 0
+	for _, number := range numbers {
+		var overdraftEvent *events.AccountOverdraft
+		charged := false
+		err = s.TransactionManager.RunReadCommitted(ctx, transaction_manager.ReadWrite,
+			func(txCtx context.Context) error { // TRANSANCTION SCOPE
+				acc, err := s.AccountStorage.GetByNumberForUpdate(txCtx, number)
+				if err != nil {
+					return err
+				}
+
+				accruals, err := s.AccountStorage.ListUnpostedAccrualsForUpdate(txCtx, acc.ID, kind, before)
+				if err != nil {
+					return err
+				}
+
+				total, err := s.AccountStorage.GetInterestCarryForUpdate(txCtx, acc.ID, kind)
+				if err != nil {
+					return err
+				}
+				for _, a := range accruals {
+					total = total.Add(a.Amount)
+				}
+
+				cur, err := s.Currencies.Get(acc.Currency)
+				if err != nil {
+					return models.ErrUnsupportedCurrency
+				}
+
+				// whole minor units are posted, the rest is carried over to next posting,
+				// so neither customer nor bank gets rounding difference
+				amount := total.Truncate(cur.MinorUnits)
+				if !amount.IsPositive() {
+					return nil
+				}
+
+				delta := amount
+				trx := account_storage.Transaction{
+					ID:             uuid.New(),
+					Type:           string(trxType),
+					DebitAmount:    amount,
+					DebitCurrency:  acc.Currency,
+					CreditAmount:   amount,
+					CreditCurrency: acc.Currency,
+					Description:    description,
+					InitiatedBy:    "system",
+					CreatedAt:      time.Now(),
+				}
+				if kind == models.InterestKindOverdraft {
+					delta = amount.Neg()
+					trx.FromAccountID, trx.FromNumber = &acc.ID, acc.Number
+				} else {
+					trx.ToAccountID, trx.ToNumber = &acc.ID, acc.Number
+				}
+
+				balance, overdraft, err := s.addBalance(txCtx, acc, delta)
+				if err != nil {
+					return err
+				}
+				overdraftEvent = overdraft
+
+				if err = s.AccountStorage.CreateTransaction(txCtx, &trx); err != nil {
+					return err
+				}
+
+				err = s.AccountStorage.CreateEntry(txCtx, &account_storage.Entry{
+					AccountID:     acc.ID,
+					TransactionID: trx.ID,
+					Type:          trx.Type,
+					Amount:        delta,
+					Currency:      acc.Currency,
+					BalanceAfter:  balance,
+					Description:   trx.Description,
+					CreatedAt:     trx.CreatedAt,
+				})
+				if err != nil {
+					return err
+				}
+
+				if err = s.AccountStorage.SetInterestCarry(txCtx, acc.ID, kind, total.Sub(amount)); err != nil {
+					return err
+				}
+
+				charged = true
+				return s.AccountStorage.MarkAccrualsPosted(txCtx, acc.ID, kind, before, trx.ID)
+			},
+		)
+		if err != nil {
+			log.Error("failed to post interest", slog.String("number", number), slog_helper.Err(err))
+			return posted, err
+		}
+
+		if charged {
+			posted++
+		}
+		s.sendOverdraftEvents(log, overdraftEvent)
+	}
+
+	return posted, nil
+}
+
+// dailyInterest - simple interest for one day with annual rate
+func dailyInterest(amount, annualRate decimal.Decimal) decimal.Decimal {
+	return amount.Mul(annualRate).DivRound(decimal.NewFromInt(daysInYear), accrualPrecision)
+}
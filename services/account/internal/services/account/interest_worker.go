@@ -0,0 +1,92 @@
+package account
+
+import (
+	slog_helper "account/internal/slog"
+	"context"
+	"log/slog"
+	"time"
+)
+
+// InterestAccruer - daily accrual and monthly posting of interest
+type InterestAccruer interface {
+	AccrueOverdraftInterest(ctx context.Context, date time.Time) (int, error)
+	PostOverdraftInterest(ctx context.Context, before time.Time) (int, error)
+	AccrueInterest(ctx context.Context, date time.Time) (int, error)
+	PostInterest(ctx context.Context, before time.Time) (int, error)
+}
+
+// InterestWorker - accrues interest for last closed days and posts interest of closed months,
+// both steps are idempotent so missed days are caught up within lookback
+type InterestWorker struct {
+	accruer      InterestAccruer
+	logger       *slog.Logger
+	interval     time.Duration
+	lookbackDays int
+}
+
+func NewInterestWorker(accruer InterestAccruer, logger *slog.Logger, interval time.Duration, lookbackDays int) *InterestWorker {
+	return &InterestWorker{
+		accruer:      accruer,
+		logger:       logger,
+		interval:     interval,
+		lookbackDays: lookbackDays,
+	}
+}
+
+func (w *InterestWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	w.logger.Info("interest worker started", slog.Duration("interval", w.interval))
+
+	w.process(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			w.process(ctx)
+		case <-ctx.Done():
+			w.logger.Info("interest worker stopped")
+			return
+		}
+	}
+}
+
+func (w *InterestWorker) process(ctx context.Context) {
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	for i := w.lookbackDays; i >= 1; i-- {
+		day := today.AddDate(0, 0, -i)
+		log := w.logger.With(slog.String("date", day.Format(time.DateOnly)))
+
+		accrued, err := w.accruer.AccrueInterest(ctx, day)
+		if err != nil {
+			log.Error("failed to accrue interest", slog_helper.Err(err))
+			return
+		}
+		overdraftAccrued, err := w.accruer.AccrueOverdraftInterest(ctx, day)
+		if err != nil {
+			log.Error("failed to accrue overdraft interest", slog_helper.Err(err))
+			return
+		}
+		if accrued > 0 || overdraftAccrued > 0 {
+			log.Info("interest accrued", slog.Int("accounts", accrued), slog.Int("overdraft_accounts", overdraftAccrued))
+		}
+	}
+
+	// accruals of closed months are posted on the first run of new month
+	monthStart := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	posted, err := w.accruer.PostInterest(ctx, monthStart)
+	if err != nil {
+		w.logger.Error("failed to post interest", slog_helper.Err(err))
+		return
+	}
+	overdraftPosted, err := w.accruer.PostOverdraftInterest(ctx, monthStart)
+	if err != nil {
+		w.logger.Error("failed to post overdraft interest", slog_helper.Err(err))
+		return
+	}
+	if posted > 0 || overdraftPosted > 0 {
+		w.logger.Info("interest posted", slog.Int("accounts", posted), slog.Int("overdraft_accounts", overdraftPosted))
+	}
+}
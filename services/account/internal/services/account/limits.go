@@ -0,0 +1,173 @@
+package account
+
+import (
+	"account/internal/models"
+	"account/internal/repository/account_storage"
+	slog_helper "account/internal/slog"
+	"context"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/R1ckNash/Bank/pkg/transaction_manager"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"time"
+)
+
+// SetLimits - operator overrides outgoing limits of product for account, nil limit falls back to product one
+func (s *accountService) SetLimits(ctx context.Context, number string, settings models.LimitsSettings) (models.AccountLimits, error) {
+	const op = "accountService.SetLimits"
+
+	log := s.Logger.With(
+		slog.String("op", op),
+		slog.String("number", number),
+		slog.String("changed_by", settings.ChangedBy),
+	)
+
+	log.Info("Processing request for set limits")
+
+	var result models.AccountLimits
+	err := s.TransactionManager.RunSerializable(ctx, transaction_manager.ReadWrite,
+		func(txCtx context.Context) error { // TRANSANCTION SCOPE
+			acc, err := s.AccountStorage.GetByNumberForUpdate(txCtx, number)
+			if err != nil {
+				return err
+			}
+
+			if acc.Status == string(models.StatusClosed) {
+				return models.ErrAccountNotActive
+			}
+
+			cur, err := s.Currencies.Get(acc.Currency)
+			if err != nil {
+				return models.ErrUnsupportedCurrency
+			}
+			for _, limit := range []*decimal.Decimal{settings.PerTransaction, settings.Daily, settings.Monthly} {
+				if limit != nil && (limit.IsNegative() || !cur.Round(*limit).Equal(*limit)) {
+					return models.ErrInvalidLimits
+				}
+			}
+
+			err = s.AccountStorage.UpsertLimits(txCtx, &account_storage.Limits{
+				AccountID:      acc.ID,
+				PerTransaction: settings.PerTransaction,
+				Daily:          settings.Daily,
+				Monthly:        settings.Monthly,
+				UpdatedBy:      settings.ChangedBy,
+				UpdatedAt:      time.Now(),
+			})
+			if err != nil {
+				return err
+			}
+
+			result, err = s.accountLimits(txCtx, acc)
+			return err
+		},
+	)
+	if err != nil {
+		log.Warn("error setting limits", slog_helper.Err(err))
+		return models.AccountLimits{}, pkgerrors.Wrap(op, err)
+	}
+
+	return result, nil
+}
+
+// GetLimits - effective limits of account and its usage in current UTC day and month
+func (s *accountService) GetLimits(ctx context.Context, number string) (models.AccountLimits, error) {
+	const op = "accountService.GetLimits"
+
+	acc, err := s.AccountStorage.GetByNumber(ctx, number)
+	if err != nil {
+		return models.AccountLimits{}, pkgerrors.Wrap(op, err)
+	}
+
+	result, err := s.accountLimits(ctx, acc)
+	if err != nil {
+		return models.AccountLimits{}, pkgerrors.Wrap(op, err)
+	}
+
+	return result, nil
+}
+
+// checkLimits - counts outgoing amount of locked account and fails when any of its limits is exceeded,
+// counters are rolled back together with transaction of payment
+func (s *accountService) checkLimits(txCtx context.Context, acc account_storage.Account, amount decimal.Decimal, at time.Time) error {
+	effective, _, err := s.limitsOf(txCtx, acc)
+	if err != nil {
+		return err
+	}
+
+	if effective.PerTransaction != nil && amount.GreaterThan(*effective.PerTransaction) {
+		return models.ErrTransactionLimitExceeded
+	}
+
+	daily, err := s.AccountStorage.AddOutflow(txCtx, acc.ID, models.PeriodDay, models.PeriodStart(models.PeriodDay, at), amount)
+	if err != nil {
+		return err
+	}
+	if effective.Daily != nil && daily.GreaterThan(*effective.Daily) {
+		return models.ErrDailyLimitExceeded
+	}
+
+	monthly, err := s.AccountStorage.AddOutflow(txCtx, acc.ID, models.PeriodMonth, models.PeriodStart(models.PeriodMonth, at), amount)
+	if err != nil {
+		return err
+	}
+	if effective.Monthly != nil && monthly.GreaterThan(*effective.Monthly) {
+		return models.ErrMonthlyLimitExceeded
+	}
+
+	return nil
+}
+
+// limitsOf - limits of product overridden by per-account ones, and per-account ones alone
+func (s *accountService) limitsOf(ctx context.Context, acc account_storage.Account) (models.Limits, models.Limits, error) {
+	product, err := s.Products.Get(acc.Product)
+	if err != nil {
+		return models.Limits{}, models.Limits{}, err
+	}
+
+	stored, err := s.AccountStorage.GetLimits(ctx, acc.ID)
+	if err != nil {
+		return models.Limits{}, models.Limits{}, err
+	}
+
+	override := models.Limits{
+		PerTransaction: stored.PerTransaction,
+		Daily:          stored.Daily,
+		Monthly:        stored.Monthly,
+	}
+
+	return product.LimitsIn(acc.Currency).Override(override), override, nil
+}
+
+func (s *accountService) accountLimits(ctx context.Context, acc account_storage.Account) (models.AccountLimits, error) {
+	effective, override, err := s.limitsOf(ctx, acc)
+	if err != nil {
+		return models.AccountLimits{}, err
+	}
+
+	now := time.Now().UTC()
+	daily, err := s.AccountStorage.GetOutflow(ctx, acc.ID, models.PeriodDay, models.PeriodStart(models.PeriodDay, now))
+	if err != nil {
+		return models.AccountLimits{}, err
+	}
+	monthly, err := s.AccountStorage.GetOutflow(ctx, acc.ID, models.PeriodMonth, models.PeriodStart(models.PeriodMonth, now))
+	if err != nil {
+		return models.AccountLimits{}, err
+	}
+
+	format := decimal.Decimal.String
+	if cur, err := s.Currencies.Get(acc.Currency); err == nil {
+		format = cur.Format
+	}
+
+	return models.AccountLimits{
+		AccountNumber: acc.Number,
+		Currency:      acc.Currency,
+		Product:       acc.Product,
+		Effective:     effective,
+		Override:      override,
+		DailyUsed:     format(daily),
+		MonthlyUsed:   format(monthly),
+		CalculatedAt:  now,
+	}, nil
+}
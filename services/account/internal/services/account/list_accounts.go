@@ -0,0 +1,49 @@
+package account
+
+import (
+	"account/internal/models"
+	"account/internal/repository/account_storage"
+	slog_helper "account/internal/slog"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"log/slog"
+)
+
+func (s *accountService) ListAccounts(ctx context.Context, ownerID uuid.UUID, filter models.AccountFilter) ([]models.Account, int, error) {
+	const op = "accountService.ListAccounts"
+
+	log := s.Logger.With(
+		slog.String("op", op),
+	)
+
+	log.Info("Processing request for list accounts", slog.String("owner_id", ownerID.String()))
+
+	if filter.Limit <= 0 {
+		filter.Limit = models.ListLimitDefault
+	}
+	if filter.Limit > models.ListLimitMax {
+		filter.Limit = models.ListLimitMax
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	accountsDB, total, err := s.AccountStorage.ListByOwner(ctx, ownerID, account_storage.ListFilter{
+		Currency: filter.Currency,
+		Status:   string(filter.Status),
+		Limit:    filter.Limit,
+		Offset:   filter.Offset,
+	})
+	if err != nil {
+		log.Error("failed to list accounts", slog_helper.Err(err))
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	accounts := make([]models.Account, 0, len(accountsDB))
+	for _, accDB := range accountsDB {
+		accounts = append(accounts, s.toAccountModel(accDB))
+	}
+
+	return accounts, total, nil
+}
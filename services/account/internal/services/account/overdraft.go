@@ -0,0 +1,117 @@
+package account
+
+import (
+	"account/internal/models"
+	"account/internal/repository/account_storage"
+	slog_helper "account/internal/slog"
+	"context"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/R1ckNash/Bank/pkg/transaction_manager"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"time"
+)
+
+// SetOverdraft - operator approves overdraft limit and interest rate, zero limit disables overdraft
+func (s *accountService) SetOverdraft(ctx context.Context, number string, settings models.OverdraftSettings) (models.Account, error) {
+	const op = "accountService.SetOverdraft"
+
+	log := s.Logger.With(
+		slog.String("op", op),
+		slog.String("number", number),
+		slog.String("changed_by", settings.ChangedBy),
+	)
+
+	log.Info("Processing request for set overdraft", slog.String("limit", settings.Limit.String()), slog.String("rate", settings.Rate.String()))
+
+	if settings.Limit.IsNegative() || settings.Rate.IsNegative() || settings.Rate.GreaterThan(decimal.NewFromInt(1)) {
+		return models.Account{}, pkgerrors.Wrap(op, models.ErrInvalidOverdraft)
+	}
+
+	var acc account_storage.Account
+	err := s.TransactionManager.RunReadCommitted(ctx, transaction_manager.ReadWrite,
+		func(txCtx context.Context) error { // TRANSANCTION SCOPE
+			var err error
+			if acc, err = s.AccountStorage.GetByNumberForUpdate(txCtx, number); err != nil {
+				return err
+			}
+
+			if acc.Status == string(models.StatusClosed) {
+				return models.ErrAccountNotActive
+			}
+
+			cur, err := s.Currencies.Get(acc.Currency)
+			if err != nil {
+				return models.ErrUnsupportedCurrency
+			}
+			if !cur.Round(settings.Limit).Equal(settings.Limit) {
+				return models.ErrInvalidOverdraft
+			}
+
+			acc.OverdraftLimit, acc.OverdraftRate = settings.Limit, settings.Rate
+			return s.AccountStorage.UpdateOverdraft(txCtx, acc.ID, settings.Limit, settings.Rate)
+		},
+	)
+	if err != nil {
+		log.Warn("error setting overdraft", slog_helper.Err(err))
+		return models.Account{}, pkgerrors.Wrap(op, err)
+	}
+
+	return s.toAccountModel(acc), nil
+}
+
+// AccrueOverdraftInterest - daily interest on end-of-day negative balance of date (UTC), idempotent per account and date
+func (s *accountService) AccrueOverdraftInterest(ctx context.Context, date time.Time) (int, error) {
+	const op = "accountService.AccrueOverdraftInterest"
+
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	endOfDay := day.AddDate(0, 0, 1)
+
+	accrued := 0
+	var afterID int64
+	for {
+		accounts, err := s.AccountStorage.ListOverdraftAccounts(ctx, afterID, accrualPageSize)
+		if err != nil {
+			return accrued, pkgerrors.Wrap(op, err)
+		}
+
+		for _, acc := range accounts {
+			balance, err := s.AccountStorage.GetBalanceAt(ctx, acc.ID, endOfDay)
+			if err != nil {
+				return accrued, pkgerrors.Wrap(op, err)
+			}
+			if !balance.IsNegative() {
+				continue
+			}
+
+			created, err := s.AccountStorage.CreateAccrual(ctx, &account_storage.InterestAccrual{
+				AccountID:   acc.ID,
+				AccrualDate: day,
+				Kind:        models.InterestKindOverdraft,
+				Balance:     balance,
+				Rate:        acc.OverdraftRate,
+				Amount:      dailyInterest(balance.Neg(), acc.OverdraftRate),
+				CreatedAt:   time.Now(),
+			})
+			if err != nil {
+				return accrued, pkgerrors.Wrap(op, err)
+			}
+			if created {
+				accrued++
+			}
+		}
+
+		if len(accounts) < accrualPageSize {
+			return accrued, nil
+		}
+		afterID = accounts[len(accounts)-1].ID
+	}
+}
+
+// PostOverdraftInterest - charges overdraft interest accrued before date, one transaction per account
+func (s *accountService) PostOverdraftInterest(ctx context.Context, before time.Time) (int, error) {
+	const op = "accountService.PostOverdraftInterest"
+
+	posted, err := s.postAccruals(ctx, models.InterestKindOverdraft, before)
+	return posted, pkgerrors.Wrap(op, err)
+}
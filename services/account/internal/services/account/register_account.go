@@ -6,9 +6,12 @@ import (
 	slog_helper "account/internal/slog"
 	"context"
 	"encoding/json"
+	"errors"
 	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
-	"github.com/R1ckNash/Bank/pkg/helpers"
+	"github.com/R1ckNash/Bank/pkg/events"
+	"github.com/R1ckNash/Bank/pkg/retry"
 	"github.com/R1ckNash/Bank/pkg/transaction_manager"
+	"github.com/shopspring/decimal"
 	"log/slog"
 	"time"
 )
@@ -22,27 +25,56 @@ func (s *accountService) RegisterAccount(ctx context.Context, acc *models.Accoun
 
 	log.Info("Processing request for create account", slog.String("owner_id", acc.OwnerID.String()))
 
-	//todo add validation
 	//todo add idempotency
 
+	cur, err := s.Currencies.Get(acc.Currency)
+	if err != nil {
+		log.Warn("unsupported currency", slog.String("currency", acc.Currency))
+		return pkgerrors.Wrap(op, models.ErrUnsupportedCurrency)
+	}
+
+	if acc.Product == "" {
+		acc.Product = models.ProductChecking
+	}
+	if _, err = s.Products.Get(acc.Product); err != nil {
+		log.Warn("unknown product", slog.String("product", acc.Product))
+		return pkgerrors.Wrap(op, models.ErrUnknownProduct)
+	}
+
 	accountDTO := &account_storage.Account{
 		OwnerID:   acc.OwnerID,
 		Name:      acc.Name,
-		Currency:  acc.Currency,
+		Currency:  cur.Code,
+		Product:   acc.Product,
 		Email:     acc.Email,
-		IsBlocked: false,
-		Balance:   0,
+		Status:    string(models.StatusActive),
+		Balance:   decimal.Zero,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
-	err := helpers.WithRetries(ctx, func(ctx context.Context) error {
+	// random number may collide with existing one, next attempt generates new number
+	policy := retry.DefaultPolicy()
+	policy.Retryable = func(err error) bool {
+		return errors.Is(err, models.ErrAlreadyExists) || retry.IsRetryable(err)
+	}
+
+	err = retry.Do(ctx, policy, func(ctx context.Context) error {
 		var err error
+		if accountDTO.Number, err = s.NumberGenerator.Generate(); err != nil {
+			return err
+		}
+
 		err = s.TransactionManager.RunReadCommitted(ctx, transaction_manager.ReadWrite,
 			func(txCtx context.Context) error { // TRANSANCTION SCOPE
 				if err = s.AccountStorage.CreateAccount(txCtx, accountDTO); err != nil {
 					return err
 				}
+
+				// failed attempt with colliding number never reaches commit, so event is sent once
+				transaction_manager.AfterCommit(txCtx, func(context.Context) {
+					s.sendAccountCreated(log, accountDTO)
+				})
 				return nil
 			},
 		)
@@ -54,13 +86,28 @@ func (s *accountService) RegisterAccount(ctx context.Context, acc *models.Accoun
 		return pkgerrors.Wrap(op, err)
 	}
 
-	accJson, err := json.Marshal(accountDTO)
+	acc.ID = accountDTO.ID
+	acc.Number = accountDTO.Number
+	acc.Status = accountDTO.Status
+	acc.Currency = cur.Code
+	acc.Balance = cur.Format(accountDTO.Balance)
+
+	return nil
+}
+
+func (s *accountService) sendAccountCreated(log *slog.Logger, accountDTO *account_storage.Account) {
+	accJson, err := json.Marshal(events.AccountCreated{
+		AccountNumber: accountDTO.Number,
+		OwnerID:       accountDTO.OwnerID,
+		Name:          accountDTO.Name,
+		Currency:      accountDTO.Currency,
+		Email:         accountDTO.Email,
+		CreatedAt:     accountDTO.CreatedAt,
+	})
 	if err != nil {
-		log.Error("could not marshall created account with : ", slog.Int64("id", accountDTO.ID))
-		return pkgerrors.Wrap(op, err)
+		log.Error("could not marshall created account with : ", slog.String("number", accountDTO.Number))
+		return
 	}
 
-	s.EventProducer.SendMessage("AccountCreated", acc.Email, accJson)
-
-	return nil
+	s.EventProducer.SendMessage(events.TopicAccountCreated, accountDTO.OwnerID.String(), accJson)
 }
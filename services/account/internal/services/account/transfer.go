@@ -0,0 +1,266 @@
+package account
+
+import (
+	"account/internal/models"
+	"account/internal/repository/account_storage"
+	slog_helper "account/internal/slog"
+	"context"
+	"encoding/json"
+	"errors"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/R1ckNash/Bank/pkg/events"
+	"github.com/R1ckNash/Bank/pkg/transaction_manager"
+	"github.com/google/uuid"
+	"log/slog"
+	"time"
+)
+
+// Transfer - moves money between accounts of the same owner or, unless OwnAccountsOnly, to any active account,
+// amount is in currency of source account and converted with FX quote when currencies differ
+func (s *accountService) Transfer(ctx context.Context, req models.TransferRequest) (models.Transaction, error) {
+	const op = "accountService.Transfer"
+
+	log := s.Logger.With(
+		slog.String("op", op),
+		slog.String("from", req.From),
+		slog.String("to", req.To),
+		slog.String("idempotency_key", req.IdempotencyKey),
+	)
+
+	log.Info("Processing request for transfer", slog.String("amount", req.Amount.String()))
+
+	if !req.Amount.IsPositive() {
+		return models.Transaction{}, pkgerrors.Wrap(op, models.ErrInvalidAmount)
+	}
+	if req.From == req.To {
+		return models.Transaction{}, pkgerrors.Wrap(op, models.ErrSameAccount)
+	}
+
+	if req.IdempotencyKey != "" {
+		existing, err := s.AccountStorage.GetTransactionByIdempotencyKey(ctx, req.InitiatedBy.String(), req.IdempotencyKey)
+		if err == nil {
+			log.Info("transfer already executed", slog.String("transaction_id", existing.ID.String()))
+			return s.replayTransfer(existing, req)
+		}
+		if !errors.Is(err, models.ErrNotFound) {
+			log.Error("failed to check idempotency key", slog_helper.Err(err))
+			return models.Transaction{}, pkgerrors.Wrap(op, err)
+		}
+	}
+
+	var trx account_storage.Transaction
+	var overdraftEvents []*events.AccountOverdraft
+	err := s.TransactionManager.RunSerializable(ctx, transaction_manager.ReadWrite,
+		func(txCtx context.Context) error { // TRANSANCTION SCOPE
+			from, to, err := s.lockPair(txCtx, req.From, req.To)
+			if err != nil {
+				return err
+			}
+
+			if from.OwnerID != req.InitiatedBy || req.OwnAccountsOnly && to.OwnerID != req.InitiatedBy {
+				return models.ErrForbidden
+			}
+			if from.Status != string(models.StatusActive) || to.Status != string(models.StatusActive) {
+				return models.ErrAccountNotActive
+			}
+
+			debitCur, err := s.Currencies.Get(from.Currency)
+			if err != nil {
+				return models.ErrUnsupportedCurrency
+			}
+			creditCur, err := s.Currencies.Get(to.Currency)
+			if err != nil {
+				return models.ErrUnsupportedCurrency
+			}
+
+			// amount with more decimals than currency has can't be debited exactly
+			if !debitCur.Round(req.Amount).Equal(req.Amount) {
+				return models.ErrInvalidAmount
+			}
+			if from.Available().LessThan(req.Amount) {
+				return models.ErrInsufficientFunds
+			}
+			if err = s.checkLimits(txCtx, from, req.Amount, time.Now()); err != nil {
+				return err
+			}
+
+			trx = account_storage.Transaction{
+				ID:             uuid.New(),
+				Type:           string(models.TransactionTransfer),
+				FromAccountID:  &from.ID,
+				ToAccountID:    &to.ID,
+				FromNumber:     from.Number,
+				ToNumber:       to.Number,
+				DebitAmount:    req.Amount,
+				DebitCurrency:  debitCur.Code,
+				CreditAmount:   req.Amount,
+				CreditCurrency: creditCur.Code,
+				Description:    req.Description,
+				InitiatedBy:    req.InitiatedBy.String(),
+				CreatedAt:      time.Now(),
+			}
+			if req.IdempotencyKey != "" {
+				trx.IdempotencyKey = &req.IdempotencyKey
+			}
+
+			if debitCur.Code != creditCur.Code {
+				quote, err := s.FXQuoter.ResolveQuote(txCtx, debitCur.Code, creditCur.Code, req.QuoteID)
+				if err != nil {
+					return err
+				}
+
+				trx.CreditAmount = quote.Convert(req.Amount, creditCur)
+				trx.FXSnapshotID = &quote.SnapshotID
+				trx.FXMidRate = &quote.MidRate
+				trx.FXSpread = &quote.Spread
+				trx.FXRate = &quote.Rate
+				if req.QuoteID != nil {
+					trx.FXQuoteID = &quote.ID
+				}
+			} else if req.QuoteID != nil {
+				return models.ErrQuoteMismatch
+			}
+
+			if !trx.CreditAmount.IsPositive() {
+				return models.ErrInvalidAmount
+			}
+
+			fromBalance, fromOverdraft, err := s.addBalance(txCtx, from, req.Amount.Neg())
+			if err != nil {
+				return err
+			}
+			toBalance, toOverdraft, err := s.addBalance(txCtx, to, trx.CreditAmount)
+			if err != nil {
+				return err
+			}
+			overdraftEvents = []*events.AccountOverdraft{fromOverdraft, toOverdraft}
+
+			if err = s.AccountStorage.CreateTransaction(txCtx, &trx); err != nil {
+				return err
+			}
+
+			err = s.AccountStorage.CreateEntry(txCtx, &account_storage.Entry{
+				AccountID:     from.ID,
+				TransactionID: trx.ID,
+				Type:          trx.Type,
+				Amount:        req.Amount.Neg(),
+				Currency:      trx.DebitCurrency,
+				BalanceAfter:  fromBalance,
+				Counterparty:  to.Number,
+				Description:   trx.Description,
+				CreatedAt:     trx.CreatedAt,
+			})
+			if err != nil {
+				return err
+			}
+
+			return s.AccountStorage.CreateEntry(txCtx, &account_storage.Entry{
+				AccountID:     to.ID,
+				TransactionID: trx.ID,
+				Type:          trx.Type,
+				Amount:        trx.CreditAmount,
+				Currency:      trx.CreditCurrency,
+				BalanceAfter:  toBalance,
+				Counterparty:  from.Number,
+				Description:   trx.Description,
+				CreatedAt:     trx.CreatedAt,
+			})
+		},
+	)
+	if err != nil {
+		// concurrent request with same key won the race, its row may not be on replica yet
+		if req.IdempotencyKey != "" && errors.Is(err, models.ErrAlreadyExists) {
+			existing, getErr := s.AccountStorage.GetTransactionByIdempotencyKey(transaction_manager.WithPrimary(ctx), req.InitiatedBy.String(), req.IdempotencyKey)
+			if getErr == nil {
+				return s.replayTransfer(existing, req)
+			}
+		}
+
+		log.Warn("error executing transfer", slog_helper.Err(err))
+		return models.Transaction{}, pkgerrors.Wrap(op, err)
+	}
+
+	result := s.toTransactionModel(trx)
+	s.sendOverdraftEvents(log, overdraftEvents...)
+
+	event := events.TransferCompleted{
+		TransactionID:  trx.ID,
+		From:           trx.FromNumber,
+		To:             trx.ToNumber,
+		DebitAmount:    result.DebitAmount,
+		DebitCurrency:  trx.DebitCurrency,
+		CreditAmount:   result.CreditAmount,
+		CreditCurrency: trx.CreditCurrency,
+		InitiatedBy:    trx.InitiatedBy,
+		CreatedAt:      trx.CreatedAt,
+	}
+	if trx.FXRate != nil {
+		event.FXRate = trx.FXRate.String()
+	}
+
+	eventJson, err := json.Marshal(event)
+	if err != nil {
+		log.Error("could not marshall transfer completed event", slog_helper.Err(err))
+		return result, nil
+	}
+
+	if err = s.EventProducer.SendMessage(events.TopicTransferCompleted, trx.FromNumber, eventJson); err != nil {
+		log.Error("could not send transfer completed event", slog_helper.Err(err))
+	}
+
+	return result, nil
+}
+
+// replayTransfer - result of the first request with the same key, the key can't be reused for another transfer
+func (s *accountService) replayTransfer(existing account_storage.Transaction, req models.TransferRequest) (models.Transaction, error) {
+	const op = "accountService.replayTransfer"
+
+	sameQuote := (existing.FXQuoteID == nil && req.QuoteID == nil) ||
+		(existing.FXQuoteID != nil && req.QuoteID != nil && *existing.FXQuoteID == *req.QuoteID)
+
+	if existing.FromNumber != req.From || existing.ToNumber != req.To || !existing.DebitAmount.Equal(req.Amount) || !sameQuote {
+		return models.Transaction{}, pkgerrors.Wrap(op, models.ErrIdempotencyMismatch)
+	}
+
+	return s.toTransactionModel(existing), nil
+}
+
+// lockPair - locks both accounts in number order so concurrent opposite transfers don't deadlock
+func (s *accountService) lockPair(ctx context.Context, fromNumber, toNumber string) (from, to account_storage.Account, err error) {
+	first, second := fromNumber, toNumber
+	if second < first {
+		first, second = second, first
+	}
+
+	firstAcc, err := s.AccountStorage.GetByNumberForUpdate(ctx, first)
+	if err != nil {
+		return from, to, err
+	}
+	secondAcc, err := s.AccountStorage.GetByNumberForUpdate(ctx, second)
+	if err != nil {
+		return from, to, err
+	}
+
+	if first == fromNumber {
+		return firstAcc, secondAcc, nil
+	}
+	return secondAcc, firstAcc, nil
+}
+
+func (s *accountService) toTransactionModel(t account_storage.Transaction) models.Transaction {
+	return models.Transaction{
+		ID:             t.ID,
+		Type:           models.TransactionType(t.Type),
+		From:           t.FromNumber,
+		To:             t.ToNumber,
+		DebitAmount:    s.formatAmount(t.DebitCurrency, t.DebitAmount),
+		DebitCurrency:  t.DebitCurrency,
+		CreditAmount:   s.formatAmount(t.CreditCurrency, t.CreditAmount),
+		CreditCurrency: t.CreditCurrency,
+		FXRate:         t.FXRate,
+		FXMidRate:      t.FXMidRate,
+		FXSpread:       t.FXSpread,
+		Description:    t.Description,
+		CreatedAt:      t.CreatedAt,
+	}
+}
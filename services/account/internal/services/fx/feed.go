@@ -0,0 +1,129 @@
+package fx
+
+import (
+	"account/internal/models"
+	slog_helper "account/internal/slog"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"github.com/shopspring/decimal"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+)
+
+// FileFeed - loads rates from CSV file ("base,quote,rate" per line, # for comments) every time it changes,
+// unchanged file is loaded again after half of Validity so rates don't expire while file is still the source
+type FileFeed struct {
+	Service  FXService
+	Logger   *slog.Logger
+	Path     string
+	Interval time.Duration
+	// Validity - how long loaded rates are valid
+	Validity time.Duration
+
+	lastModified time.Time
+	lastLoaded   time.Time
+}
+
+func NewFileFeed(service FXService, logger *slog.Logger, path string, interval, validity time.Duration) *FileFeed {
+	return &FileFeed{
+		Service:  service,
+		Logger:   logger,
+		Path:     path,
+		Interval: interval,
+		Validity: validity,
+	}
+}
+
+func (f *FileFeed) Run(ctx context.Context) {
+	ticker := time.NewTicker(f.Interval)
+	defer ticker.Stop()
+	f.Logger.Info("fx file feed started", slog.String("path", f.Path), slog.Duration("interval", f.Interval))
+
+	f.load(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			f.load(ctx)
+		case <-ctx.Done():
+			f.Logger.Info("fx file feed stopped")
+			return
+		}
+	}
+}
+
+func (f *FileFeed) load(ctx context.Context) {
+	info, err := os.Stat(f.Path)
+	if err != nil {
+		f.Logger.Error("failed to stat fx feed file", slog_helper.Err(err))
+		return
+	}
+	now := time.Now()
+	if !info.ModTime().After(f.lastModified) && now.Sub(f.lastLoaded) < f.Validity/2 {
+		return
+	}
+
+	file, err := os.Open(f.Path)
+	if err != nil {
+		f.Logger.Error("failed to open fx feed file", slog_helper.Err(err))
+		return
+	}
+	defer file.Close()
+
+	rates, err := parseFeed(file)
+	if err != nil {
+		f.Logger.Error("failed to parse fx feed file", slog_helper.Err(err))
+		return
+	}
+
+	snapshot := &models.FXRateSnapshot{
+		Source:    models.FXSourceFileFeed,
+		ValidFrom: now,
+		ValidTo:   now.Add(f.Validity),
+		CreatedBy: f.Path,
+		Rates:     rates,
+	}
+	if err = f.Service.UploadRates(ctx, snapshot); err != nil {
+		f.Logger.Error("failed to upload fx rates from feed", slog_helper.Err(err))
+		return
+	}
+
+	f.lastModified = info.ModTime()
+	f.lastLoaded = now
+	f.Logger.Info("fx rates loaded from feed", slog.Int64("snapshot_id", snapshot.ID), slog.Int("rates", len(rates)))
+}
+
+func parseFeed(r io.Reader) ([]models.FXRate, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	var rates []models.FXRate
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		rate, err := decimal.NewFromString(strings.TrimSpace(record[2]))
+		if err != nil {
+			return nil, fmt.Errorf("rate %s/%s: %w", record[0], record[1], err)
+		}
+
+		rates = append(rates, models.FXRate{
+			Base:  strings.TrimSpace(record[0]),
+			Quote: strings.TrimSpace(record[1]),
+			Rate:  rate,
+		})
+	}
+
+	return rates, nil
+}
@@ -0,0 +1,61 @@
+package fx
+
+import (
+	"account/internal/models"
+	"account/internal/repository/fx_storage"
+	"context"
+	"github.com/R1ckNash/Bank/pkg/currency"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"time"
+)
+
+//go:generate mockery --name=FXService --filename=fx_service_mock.go --disable-version-string
+type FXService interface {
+	UploadRates(ctx context.Context, snapshot *models.FXRateSnapshot) error
+	Quote(ctx context.Context, from, to string, amount decimal.Decimal) (models.FXQuote, error)
+	ResolveQuote(ctx context.Context, from, to string, quoteID *uuid.UUID) (models.FXQuote, error)
+}
+
+//go:generate mockery --name=FXStorage --filename=fx_storage_mock.go --disable-version-string
+type FXStorage interface {
+	CreateSnapshot(ctx context.Context, snapshot *fx_storage.Snapshot, rates []fx_storage.Rate) error
+	GetRate(ctx context.Context, base, quote string, at time.Time) (fx_storage.Rate, error)
+	IsSnapshotValid(ctx context.Context, snapshotID int64, at time.Time) (bool, error)
+	CreateQuote(ctx context.Context, q *fx_storage.Quote) error
+	GetQuote(ctx context.Context, id uuid.UUID) (fx_storage.Quote, error)
+}
+
+//go:generate mockery --name=Currencies
+type Currencies interface {
+	Get(code string) (currency.Currency, error)
+}
+
+// TransactionManager trx manager
+type TransactionManager interface {
+	RunReadCommitted(ctx context.Context, accessMode pgx.TxAccessMode, f func(ctx context.Context) error) error
+}
+
+type Deps struct {
+	FXStorage
+	TransactionManager
+	Currencies
+	Logger *slog.Logger
+
+	// Spread - bank margin, customer rate is mid rate * (1 - Spread)
+	Spread decimal.Decimal
+	// QuoteTTL - how long quoted rate can be used for transfer
+	QuoteTTL time.Duration
+}
+
+type fxService struct {
+	Deps
+}
+
+func NewFXService(d Deps) FXService {
+	return &fxService{
+		Deps: d,
+	}
+}
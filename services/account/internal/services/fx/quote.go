@@ -0,0 +1,137 @@
+package fx
+
+import (
+	"account/internal/models"
+	"account/internal/repository/fx_storage"
+	slog_helper "account/internal/slog"
+	"context"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// rates are stored with 10 decimals
+const ratePrecision = 10
+
+// Quote - locks customer rate for QuoteTTL, amount is optional and only used to show converted amount
+func (s *fxService) Quote(ctx context.Context, from, to string, amount decimal.Decimal) (models.FXQuote, error) {
+	const op = "fxService.Quote"
+
+	log := s.Logger.With(
+		slog.String("op", op),
+		slog.String("from", from),
+		slog.String("to", to),
+	)
+
+	log.Info("Processing request for fx quote")
+
+	fromCur, err := s.Currencies.Get(strings.ToUpper(from))
+	if err != nil {
+		return models.FXQuote{}, pkgerrors.Wrap(op, models.ErrUnsupportedCurrency)
+	}
+	toCur, err := s.Currencies.Get(strings.ToUpper(to))
+	if err != nil {
+		return models.FXQuote{}, pkgerrors.Wrap(op, models.ErrUnsupportedCurrency)
+	}
+
+	quote, err := s.currentQuote(ctx, fromCur.Code, toCur.Code)
+	if err != nil {
+		log.Warn("failed to get fx rate", slog_helper.Err(err))
+		return models.FXQuote{}, pkgerrors.Wrap(op, err)
+	}
+
+	quote.ID = uuid.New()
+	quote.ExpiresAt = time.Now().Add(s.QuoteTTL)
+
+	err = s.FXStorage.CreateQuote(ctx, &fx_storage.Quote{
+		ID:            quote.ID,
+		SnapshotID:    quote.SnapshotID,
+		BaseCurrency:  quote.From,
+		QuoteCurrency: quote.To,
+		MidRate:       quote.MidRate,
+		Spread:        quote.Spread,
+		Rate:          quote.Rate,
+		CreatedAt:     time.Now(),
+		ExpiresAt:     quote.ExpiresAt,
+	})
+	if err != nil {
+		log.Error("failed to save fx quote", slog_helper.Err(err))
+		return models.FXQuote{}, pkgerrors.Wrap(op, err)
+	}
+
+	if amount.IsPositive() {
+		quote.Amount = fromCur.Format(amount)
+		quote.ConvertedAmount = toCur.Format(quote.Convert(amount, toCur))
+	}
+
+	return quote, nil
+}
+
+// ResolveQuote - rate for transfer: stored quote if quoteID is set, otherwise current rate
+func (s *fxService) ResolveQuote(ctx context.Context, from, to string, quoteID *uuid.UUID) (models.FXQuote, error) {
+	const op = "fxService.ResolveQuote"
+
+	if quoteID == nil {
+		quote, err := s.currentQuote(ctx, from, to)
+		return quote, pkgerrors.Wrap(op, err)
+	}
+
+	q, err := s.FXStorage.GetQuote(ctx, *quoteID)
+	if err != nil {
+		return models.FXQuote{}, pkgerrors.Wrap(op, err)
+	}
+
+	if q.BaseCurrency != from || q.QuoteCurrency != to {
+		return models.FXQuote{}, pkgerrors.Wrap(op, models.ErrQuoteMismatch)
+	}
+
+	now := time.Now()
+	if !now.Before(q.ExpiresAt) {
+		return models.FXQuote{}, pkgerrors.Wrap(op, models.ErrQuoteExpired)
+	}
+
+	// rate must not be used after its snapshot validity window
+	valid, err := s.FXStorage.IsSnapshotValid(ctx, q.SnapshotID, now)
+	if err != nil {
+		return models.FXQuote{}, pkgerrors.Wrap(op, err)
+	}
+	if !valid {
+		return models.FXQuote{}, pkgerrors.Wrap(op, models.ErrQuoteExpired)
+	}
+
+	return models.FXQuote{
+		ID:         q.ID,
+		SnapshotID: q.SnapshotID,
+		From:       q.BaseCurrency,
+		To:         q.QuoteCurrency,
+		MidRate:    q.MidRate,
+		Spread:     q.Spread,
+		Rate:       q.Rate,
+		ExpiresAt:  q.ExpiresAt,
+	}, nil
+}
+
+// currentQuote - quote from latest valid snapshot without persisting it
+func (s *fxService) currentQuote(ctx context.Context, from, to string) (models.FXQuote, error) {
+	rate, err := s.FXStorage.GetRate(ctx, from, to, time.Now())
+	if err != nil {
+		return models.FXQuote{}, err
+	}
+
+	mid := rate.Rate
+	if rate.BaseCurrency != from {
+		mid = decimal.NewFromInt(1).DivRound(rate.Rate, ratePrecision)
+	}
+
+	return models.FXQuote{
+		SnapshotID: rate.SnapshotID,
+		From:       from,
+		To:         to,
+		MidRate:    mid,
+		Spread:     s.Spread,
+		Rate:       mid.Mul(decimal.NewFromInt(1).Sub(s.Spread)).Round(ratePrecision),
+	}, nil
+}
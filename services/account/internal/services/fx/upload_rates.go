@@ -0,0 +1,99 @@
+package fx
+
+import (
+	"account/internal/models"
+	"account/internal/repository/fx_storage"
+	slog_helper "account/internal/slog"
+	"context"
+	"fmt"
+	"github.com/R1ckNash/Bank/pkg/currency"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/R1ckNash/Bank/pkg/transaction_manager"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// UploadRates - stores new rates snapshot, latest snapshot wins for overlapping validity windows
+func (s *fxService) UploadRates(ctx context.Context, snapshot *models.FXRateSnapshot) error {
+	const op = "fxService.UploadRates"
+
+	log := s.Logger.With(
+		slog.String("op", op),
+		slog.String("source", snapshot.Source),
+	)
+
+	log.Info("Processing request for upload fx rates", slog.Int("rates", len(snapshot.Rates)))
+
+	if err := validateSnapshot(snapshot); err != nil {
+		log.Warn("invalid fx rates", slog_helper.Err(err))
+		return pkgerrors.Wrap(op, err)
+	}
+
+	snapshotDTO := &fx_storage.Snapshot{
+		Source:    snapshot.Source,
+		ValidFrom: snapshot.ValidFrom,
+		ValidTo:   snapshot.ValidTo,
+		CreatedBy: snapshot.CreatedBy,
+		CreatedAt: time.Now(),
+	}
+
+	ratesDTO := make([]fx_storage.Rate, 0, len(snapshot.Rates))
+	for _, rate := range snapshot.Rates {
+		ratesDTO = append(ratesDTO, fx_storage.Rate{
+			BaseCurrency:  rate.Base,
+			QuoteCurrency: rate.Quote,
+			Rate:          rate.Rate,
+		})
+	}
+
+	err := s.TransactionManager.RunReadCommitted(ctx, transaction_manager.ReadWrite,
+		func(txCtx context.Context) error { // TRANSANCTION SCOPE
+			return s.FXStorage.CreateSnapshot(txCtx, snapshotDTO, ratesDTO)
+		},
+	)
+	if err != nil {
+		log.Error("error saving fx rates", slog_helper.Err(err))
+		return pkgerrors.Wrap(op, err)
+	}
+
+	snapshot.ID = snapshotDTO.ID
+	return nil
+}
+
+// validateSnapshot - normalizes currency codes and checks rates
+func validateSnapshot(snapshot *models.FXRateSnapshot) error {
+	if len(snapshot.Rates) == 0 {
+		return fmt.Errorf("%w: no rates", models.ErrInvalidRates)
+	}
+	if !snapshot.ValidTo.After(snapshot.ValidFrom) {
+		return fmt.Errorf("%w: valid_to must be after valid_from", models.ErrInvalidRates)
+	}
+
+	seen := make(map[string]struct{}, len(snapshot.Rates))
+	for i := range snapshot.Rates {
+		rate := &snapshot.Rates[i]
+		rate.Base, rate.Quote = strings.ToUpper(rate.Base), strings.ToUpper(rate.Quote)
+
+		if _, err := currency.Lookup(rate.Base); err != nil {
+			return fmt.Errorf("%w: %w", models.ErrInvalidRates, err)
+		}
+		if _, err := currency.Lookup(rate.Quote); err != nil {
+			return fmt.Errorf("%w: %w", models.ErrInvalidRates, err)
+		}
+		if rate.Base == rate.Quote {
+			return fmt.Errorf("%w: %s/%s", models.ErrInvalidRates, rate.Base, rate.Quote)
+		}
+		if !rate.Rate.IsPositive() {
+			return fmt.Errorf("%w: rate %s/%s must be positive", models.ErrInvalidRates, rate.Base, rate.Quote)
+		}
+
+		pair := rate.Base + rate.Quote
+		if _, ok := seen[pair]; ok {
+			return fmt.Errorf("%w: duplicate %s/%s", models.ErrInvalidRates, rate.Base, rate.Quote)
+		}
+		seen[pair] = struct{}{}
+	}
+
+	return nil
+}
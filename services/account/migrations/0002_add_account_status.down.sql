@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_account_status_history_account_id;
+DROP TABLE IF EXISTS account_status_history;
+
+ALTER TABLE account ADD COLUMN IF NOT EXISTS is_blocked BOOLEAN;
+UPDATE account SET is_blocked = (status = 'blocked');
+ALTER TABLE account DROP COLUMN IF EXISTS status;
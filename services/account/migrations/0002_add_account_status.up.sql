@@ -0,0 +1,19 @@
+ALTER TABLE account ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'active'
+    CHECK (status IN ('active', 'frozen', 'blocked', 'closed'));
+
+UPDATE account SET status = 'blocked' WHERE is_blocked;
+
+ALTER TABLE account DROP COLUMN IF EXISTS is_blocked;
+
+CREATE TABLE IF NOT EXISTS account_status_history (
+    id BIGSERIAL PRIMARY KEY,
+    account_id INT NOT NULL REFERENCES account(id),
+    from_status VARCHAR(16) NOT NULL,
+    to_status VARCHAR(16) NOT NULL,
+    reason_code VARCHAR(64) NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    changed_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_status_history_account_id ON account_status_history(account_id);
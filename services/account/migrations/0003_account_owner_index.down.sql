@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_account_owner_id;
+
+ALTER TABLE account ADD CONSTRAINT account_email_key UNIQUE (email);
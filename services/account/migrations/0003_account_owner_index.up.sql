@@ -0,0 +1,3 @@
+ALTER TABLE account DROP CONSTRAINT IF EXISTS account_email_key;
+
+CREATE INDEX IF NOT EXISTS idx_account_owner_id ON account(owner_id, id);
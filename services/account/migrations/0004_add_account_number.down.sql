@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_account_number;
+
+ALTER TABLE account DROP COLUMN IF EXISTS number;
@@ -0,0 +1,30 @@
+-- public account number (IBAN-style), internal id is not exposed anymore
+ALTER TABLE account ADD COLUMN IF NOT EXISTS number VARCHAR(34);
+
+-- numbers for accounts opened before this migration, account digits are taken from id so they don't collide
+-- with each other; country and bank code must be the same as account_number in service config
+DO $$
+DECLARE
+    country_code CONSTANT TEXT := 'DE';
+    bank_code CONSTANT TEXT := '10010010';
+    acc RECORD;
+    bban TEXT;
+    digits TEXT;
+BEGIN
+    FOR acc IN SELECT id FROM account WHERE number IS NULL LOOP
+        bban := bank_code || lpad(acc.id::text, 10, '0');
+
+        -- ISO 7064 MOD 97-10, letters are replaced with numbers A=10 ... Z=35
+        SELECT string_agg(CASE WHEN c ~ '[A-Z]' THEN (ascii(c) - 55)::text ELSE c END, '' ORDER BY i)
+        INTO digits
+        FROM unnest(string_to_array(bban || country_code || '00', NULL)) WITH ORDINALITY AS t(c, i);
+
+        UPDATE account
+        SET number = country_code || lpad((98 - digits::numeric % 97)::text, 2, '0') || bban
+        WHERE id = acc.id;
+    END LOOP;
+END $$;
+
+ALTER TABLE account ALTER COLUMN number SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_account_number ON account(number);
@@ -0,0 +1,5 @@
+ALTER TABLE account ALTER COLUMN balance DROP NOT NULL;
+ALTER TABLE account ALTER COLUMN balance TYPE DECIMAL(15,2);
+
+ALTER TABLE account DROP CONSTRAINT IF EXISTS account_currency_iso4217;
+ALTER TABLE account ALTER COLUMN currency TYPE VARCHAR(255);
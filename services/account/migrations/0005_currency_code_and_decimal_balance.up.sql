@@ -0,0 +1,39 @@
+-- legacy currency was free-form text, known spellings are mapped to ISO 4217 codes
+UPDATE account SET currency = UPPER(TRIM(currency));
+UPDATE account SET currency = CASE currency
+        WHEN 'EURO' THEN 'EUR'
+        WHEN 'EUROS' THEN 'EUR'
+        WHEN '€' THEN 'EUR'
+        WHEN 'DOLLAR' THEN 'USD'
+        WHEN 'DOLLARS' THEN 'USD'
+        WHEN 'US DOLLAR' THEN 'USD'
+        WHEN '$' THEN 'USD'
+        WHEN 'POUND' THEN 'GBP'
+        WHEN '£' THEN 'GBP'
+        WHEN 'RUB.' THEN 'RUB'
+        WHEN 'RUR' THEN 'RUB'
+        ELSE currency
+    END;
+
+-- anything else must be fixed by hand, constraint below would fail with less helpful error
+DO $$
+DECLARE
+    invalid TEXT;
+BEGIN
+    SELECT string_agg(DISTINCT quote_literal(currency), ', ') INTO invalid
+    FROM account
+    WHERE currency !~ '^[A-Z]{3}$';
+
+    IF invalid IS NOT NULL THEN
+        RAISE EXCEPTION 'account.currency has values that are not ISO 4217 codes: %', invalid
+            USING HINT = 'update these accounts to 3-letter currency codes and run migration again';
+    END IF;
+END $$;
+
+ALTER TABLE account ALTER COLUMN currency TYPE VARCHAR(3);
+ALTER TABLE account ADD CONSTRAINT account_currency_iso4217 CHECK (currency ~ '^[A-Z]{3}$');
+
+-- up to 4 minor units (e.g. CLF), rounding to currency minor units is done by service
+ALTER TABLE account ALTER COLUMN balance TYPE NUMERIC(19,4);
+UPDATE account SET balance = 0 WHERE balance IS NULL;
+ALTER TABLE account ALTER COLUMN balance SET NOT NULL;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_transactions_to_account_id;
+DROP INDEX IF EXISTS idx_transactions_from_account_id;
+DROP TABLE IF EXISTS transactions;
+DROP TABLE IF EXISTS fx_quotes;
+DROP TABLE IF EXISTS fx_rates;
+DROP INDEX IF EXISTS idx_fx_rate_snapshots_validity;
+DROP TABLE IF EXISTS fx_rate_snapshots;
@@ -0,0 +1,58 @@
+CREATE TABLE IF NOT EXISTS fx_rate_snapshots (
+    id BIGSERIAL PRIMARY KEY,
+    source VARCHAR(32) NOT NULL,
+    valid_from TIMESTAMP WITH TIME ZONE NOT NULL,
+    valid_to TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CHECK (valid_to > valid_from)
+);
+
+CREATE INDEX IF NOT EXISTS idx_fx_rate_snapshots_validity ON fx_rate_snapshots(valid_from, valid_to);
+
+-- 1 base_currency = rate quote_currency (mid-market)
+CREATE TABLE IF NOT EXISTS fx_rates (
+    snapshot_id BIGINT NOT NULL REFERENCES fx_rate_snapshots(id),
+    base_currency VARCHAR(3) NOT NULL,
+    quote_currency VARCHAR(3) NOT NULL,
+    rate NUMERIC(24,10) NOT NULL CHECK (rate > 0),
+    PRIMARY KEY (snapshot_id, base_currency, quote_currency)
+);
+
+CREATE TABLE IF NOT EXISTS fx_quotes (
+    id uuid PRIMARY KEY,
+    snapshot_id BIGINT NOT NULL REFERENCES fx_rate_snapshots(id),
+    base_currency VARCHAR(3) NOT NULL,
+    quote_currency VARCHAR(3) NOT NULL,
+    mid_rate NUMERIC(24,10) NOT NULL,
+    spread NUMERIC(10,6) NOT NULL,
+    rate NUMERIC(24,10) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS transactions (
+    id uuid PRIMARY KEY,
+    type VARCHAR(32) NOT NULL,
+    idempotency_key VARCHAR(255),
+    from_account_id INT REFERENCES account(id),
+    to_account_id INT REFERENCES account(id),
+    debit_amount NUMERIC(19,4) NOT NULL,
+    debit_currency VARCHAR(3) NOT NULL,
+    credit_amount NUMERIC(19,4) NOT NULL,
+    credit_currency VARCHAR(3) NOT NULL,
+    -- applied FX: NULL for same currency
+    fx_snapshot_id BIGINT REFERENCES fx_rate_snapshots(id),
+    fx_quote_id uuid REFERENCES fx_quotes(id),
+    fx_mid_rate NUMERIC(24,10),
+    fx_spread NUMERIC(10,6),
+    fx_rate NUMERIC(24,10),
+    description TEXT NOT NULL DEFAULT '',
+    initiated_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    -- keys are chosen by callers, so they are unique per caller only
+    UNIQUE (initiated_by, idempotency_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_from_account_id ON transactions(from_account_id);
+CREATE INDEX IF NOT EXISTS idx_transactions_to_account_id ON transactions(to_account_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_account_entries_account_id_created_at;
+DROP INDEX IF EXISTS idx_account_entries_account_id_id;
+DROP TABLE IF EXISTS account_entries;
@@ -0,0 +1,16 @@
+-- ledger lines: one per account touched by transaction, amount is signed (negative for debit)
+CREATE TABLE IF NOT EXISTS account_entries (
+    id BIGSERIAL PRIMARY KEY,
+    account_id INT NOT NULL REFERENCES account(id),
+    transaction_id uuid NOT NULL REFERENCES transactions(id),
+    type VARCHAR(32) NOT NULL,
+    amount NUMERIC(19,4) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    balance_after NUMERIC(19,4) NOT NULL,
+    counterparty VARCHAR(34) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_entries_account_id_id ON account_entries(account_id, id);
+CREATE INDEX IF NOT EXISTS idx_account_entries_account_id_created_at ON account_entries(account_id, created_at);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_holds_active_expires_at;
+DROP INDEX IF EXISTS idx_holds_account_id;
+DROP TABLE IF EXISTS holds;
+ALTER TABLE account DROP COLUMN IF EXISTS held_amount;
@@ -0,0 +1,24 @@
+-- available balance = balance - held_amount
+ALTER TABLE account ADD COLUMN IF NOT EXISTS held_amount NUMERIC(19,4) NOT NULL DEFAULT 0 CHECK (held_amount >= 0);
+
+CREATE TABLE IF NOT EXISTS holds (
+    id uuid PRIMARY KEY,
+    account_id INT NOT NULL REFERENCES account(id),
+    amount NUMERIC(19,4) NOT NULL CHECK (amount > 0),
+    captured_amount NUMERIC(19,4) NOT NULL DEFAULT 0,
+    currency VARCHAR(3) NOT NULL,
+    status VARCHAR(16) NOT NULL CHECK (status IN ('active', 'captured', 'released', 'expired')),
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    idempotency_key VARCHAR(255),
+    transaction_id uuid REFERENCES transactions(id),
+    created_by VARCHAR(255) NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- keys are chosen by callers, so they are unique per caller only
+    UNIQUE (created_by, idempotency_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_holds_account_id ON holds(account_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_holds_active_expires_at ON holds(expires_at) WHERE status = 'active';
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS interest_carry;
+DROP INDEX IF EXISTS idx_interest_accruals_unposted;
+DROP TABLE IF EXISTS interest_accruals;
+ALTER TABLE account DROP COLUMN IF EXISTS overdraft_rate;
+ALTER TABLE account DROP COLUMN IF EXISTS overdraft_limit;
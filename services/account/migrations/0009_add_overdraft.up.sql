@@ -0,0 +1,27 @@
+-- available balance = balance - held_amount + overdraft_limit, overdraft_rate is annual
+ALTER TABLE account ADD COLUMN IF NOT EXISTS overdraft_limit NUMERIC(19,4) NOT NULL DEFAULT 0 CHECK (overdraft_limit >= 0);
+ALTER TABLE account ADD COLUMN IF NOT EXISTS overdraft_rate NUMERIC(9,6) NOT NULL DEFAULT 0 CHECK (overdraft_rate >= 0);
+
+-- daily interest, one row per account, day and kind; posted to account monthly
+CREATE TABLE IF NOT EXISTS interest_accruals (
+    account_id INT NOT NULL REFERENCES account(id),
+    accrual_date DATE NOT NULL,
+    kind VARCHAR(16) NOT NULL,
+    balance NUMERIC(19,4) NOT NULL,
+    rate NUMERIC(9,6) NOT NULL,
+    amount NUMERIC(19,8) NOT NULL,
+    transaction_id uuid REFERENCES transactions(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (account_id, accrual_date, kind)
+);
+
+CREATE INDEX IF NOT EXISTS idx_interest_accruals_unposted ON interest_accruals(account_id) WHERE transaction_id IS NULL;
+
+-- part of posted accruals smaller than minor unit of account currency, added to next posting
+CREATE TABLE IF NOT EXISTS interest_carry (
+    account_id INT NOT NULL REFERENCES account(id),
+    kind VARCHAR(16) NOT NULL,
+    amount NUMERIC(19,8) NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (account_id, kind)
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_account_product;
+ALTER TABLE account DROP COLUMN IF EXISTS product;
@@ -0,0 +1,4 @@
+-- product defines interest conditions, see products in service config
+ALTER TABLE account ADD COLUMN IF NOT EXISTS product VARCHAR(32) NOT NULL DEFAULT 'checking';
+
+CREATE INDEX IF NOT EXISTS idx_account_product ON account(product, id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS outflow_counters;
+DROP TABLE IF EXISTS account_limits;
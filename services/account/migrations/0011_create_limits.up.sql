@@ -0,0 +1,19 @@
+-- per-account overrides of product limits, NULL means product limit applies
+CREATE TABLE IF NOT EXISTS account_limits (
+    account_id INT PRIMARY KEY REFERENCES account(id),
+    per_transaction NUMERIC(19,4) CHECK (per_transaction >= 0),
+    daily NUMERIC(19,4) CHECK (daily >= 0),
+    monthly NUMERIC(19,4) CHECK (monthly >= 0),
+    updated_by VARCHAR(255) NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- outgoing amount per account and UTC day / month, updated in transaction of transfer
+CREATE TABLE IF NOT EXISTS outflow_counters (
+    account_id INT NOT NULL REFERENCES account(id),
+    period VARCHAR(8) NOT NULL CHECK (period IN ('day', 'month')),
+    period_start DATE NOT NULL,
+    amount NUMERIC(19,4) NOT NULL DEFAULT 0,
+    count INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (account_id, period, period_start)
+);
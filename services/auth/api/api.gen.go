@@ -0,0 +1,604 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	ServiceAuthScopes = "serviceAuth.Scopes"
+)
+
+// Defines values for OAuthErrorError.
+const (
+	InvalidClient        OAuthErrorError = "invalid_client"
+	InvalidRequest       OAuthErrorError = "invalid_request"
+	InvalidScope         OAuthErrorError = "invalid_scope"
+	ServerError          OAuthErrorError = "server_error"
+	UnsupportedGrantType OAuthErrorError = "unsupported_grant_type"
+)
+
+// Defines values for TokenRequestGrantType.
+const (
+	ClientCredentials TokenRequestGrantType = "client_credentials"
+)
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	Field   string  `json:"field"`
+	Message string  `json:"message"`
+	Param   *string `json:"param,omitempty"`
+	Rule    string  `json:"rule"`
+}
+
+// IntrospectRequest defines model for IntrospectRequest.
+type IntrospectRequest struct {
+	Token string `json:"token"`
+}
+
+// IntrospectResponse defines model for IntrospectResponse.
+type IntrospectResponse struct {
+	Active bool    `json:"active"`
+	Exp    *int64  `json:"exp,omitempty"`
+	Iat    *int64  `json:"iat,omitempty"`
+	Scope  *string `json:"scope,omitempty"`
+	Sub    *string `json:"sub,omitempty"`
+	Typ    *string `json:"typ,omitempty"`
+}
+
+// LoginRequest defines model for LoginRequest.
+type LoginRequest struct {
+	Password string `json:"password"`
+	Username string `json:"username"`
+}
+
+// LoginResponse defines model for LoginResponse.
+type LoginResponse struct {
+	// Token JWT of user
+	Token string `json:"token"`
+}
+
+// OAuthError defines model for OAuthError.
+type OAuthError struct {
+	Error OAuthErrorError `json:"error"`
+}
+
+// OAuthErrorError defines model for OAuthError.Error.
+type OAuthErrorError string
+
+// Problem RFC 7807 problem details
+type Problem struct {
+	Code      string        `json:"code"`
+	Detail    *string       `json:"detail,omitempty"`
+	Details   *[]FieldError `json:"details,omitempty"`
+	Instance  *string       `json:"instance,omitempty"`
+	RequestId *string       `json:"request_id,omitempty"`
+	Status    int           `json:"status"`
+	Title     string        `json:"title"`
+	Type      string        `json:"type"`
+}
+
+// RegistrationRequest defines model for RegistrationRequest.
+type RegistrationRequest struct {
+	Email    openapi_types.Email `json:"email"`
+	Password string              `json:"password"`
+	Username string              `json:"username"`
+}
+
+// Status defines model for Status.
+type Status struct {
+	Status string `json:"status"`
+}
+
+// TokenRequest defines model for TokenRequest.
+type TokenRequest struct {
+	ClientId     *string               `json:"client_id,omitempty"`
+	ClientSecret *string               `json:"client_secret,omitempty"`
+	GrantType    TokenRequestGrantType `json:"grant_type"`
+
+	// Scope space separated scopes
+	Scope *string `json:"scope,omitempty"`
+}
+
+// TokenRequestGrantType defines model for TokenRequest.GrantType.
+type TokenRequestGrantType string
+
+// TokenResponse defines model for TokenResponse.
+type TokenResponse struct {
+	AccessToken string `json:"access_token"`
+
+	// ExpiresIn seconds
+	ExpiresIn int64  `json:"expires_in"`
+	TokenType string `json:"token_type"`
+}
+
+// User defines model for User.
+type User struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Email     string             `json:"email"`
+	Id        openapi_types.UUID `json:"id"`
+	Role      string             `json:"role"`
+	Username  string             `json:"username"`
+}
+
+// UserID defines model for UserID.
+type UserID = openapi_types.UUID
+
+// BadRequest RFC 7807 problem details
+type BadRequest = Problem
+
+// Conflict RFC 7807 problem details
+type Conflict = Problem
+
+// Forbidden RFC 7807 problem details
+type Forbidden = Problem
+
+// NotFound RFC 7807 problem details
+type NotFound = Problem
+
+// Unauthorized RFC 7807 problem details
+type Unauthorized = Problem
+
+// IntrospectTokenFormdataRequestBody defines body for IntrospectToken for application/x-www-form-urlencoded ContentType.
+type IntrospectTokenFormdataRequestBody = IntrospectRequest
+
+// IssueTokenFormdataRequestBody defines body for IssueToken for application/x-www-form-urlencoded ContentType.
+type IssueTokenFormdataRequestBody = TokenRequest
+
+// LoginUserJSONRequestBody defines body for LoginUser for application/json ContentType.
+type LoginUserJSONRequestBody = LoginRequest
+
+// RegisterUserJSONRequestBody defines body for RegisterUser for application/json ContentType.
+type RegisterUserJSONRequestBody = RegistrationRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// RFC 7662 token introspection
+	// (POST /oauth/introspect)
+	IntrospectToken(w http.ResponseWriter, r *http.Request)
+	// OAuth2 token endpoint, only client credentials grant
+	// (POST /oauth/token)
+	IssueToken(w http.ResponseWriter, r *http.Request)
+	// Exchange username and password for JWT
+	// (POST /user/login)
+	LoginUser(w http.ResponseWriter, r *http.Request)
+	// Register customer
+	// (POST /user/registration)
+	RegisterUser(w http.ResponseWriter, r *http.Request)
+	// Check that user exists
+	// (GET /user/verify/{user_id})
+	VerifyUser(w http.ResponseWriter, r *http.Request, userId UserID)
+	// User without credentials
+	// (GET /user/{user_id})
+	GetUser(w http.ResponseWriter, r *http.Request, userId UserID)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// RFC 7662 token introspection
+// (POST /oauth/introspect)
+func (_ Unimplemented) IntrospectToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// OAuth2 token endpoint, only client credentials grant
+// (POST /oauth/token)
+func (_ Unimplemented) IssueToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Exchange username and password for JWT
+// (POST /user/login)
+func (_ Unimplemented) LoginUser(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Register customer
+// (POST /user/registration)
+func (_ Unimplemented) RegisterUser(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Check that user exists
+// (GET /user/verify/{user_id})
+func (_ Unimplemented) VerifyUser(w http.ResponseWriter, r *http.Request, userId UserID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// User without credentials
+// (GET /user/{user_id})
+func (_ Unimplemented) GetUser(w http.ResponseWriter, r *http.Request, userId UserID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// IntrospectToken operation middleware
+func (siw *ServerInterfaceWrapper) IntrospectToken(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ServiceAuthScopes, []string{"tokens:introspect"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.IntrospectToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// IssueToken operation middleware
+func (siw *ServerInterfaceWrapper) IssueToken(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.IssueToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LoginUser operation middleware
+func (siw *ServerInterfaceWrapper) LoginUser(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LoginUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RegisterUser operation middleware
+func (siw *ServerInterfaceWrapper) RegisterUser(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RegisterUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyUser operation middleware
+func (siw *ServerInterfaceWrapper) VerifyUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ServiceAuthScopes, []string{"users:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyUser(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUser operation middleware
+func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ServiceAuthScopes, []string{"users:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUser(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/oauth/introspect", wrapper.IntrospectToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/oauth/token", wrapper.IssueToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/user/login", wrapper.LoginUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/user/registration", wrapper.RegisterUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/user/verify/{user_id}", wrapper.VerifyUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/user/{user_id}", wrapper.GetUser)
+	})
+
+	return r
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+xZUXPbNhL+KxjcPdzN0Zbi+OwL32Jf3DpNG09iNw+2RwOTKwkJCTAAaFvV8L93FiAp",
+	"UAJNOdOofeibRGKxi91vv90FlzSReSEFCKNpvKQFUywHA8r+u9Kgzv+Pv7igMS2YmdOICpYDjWmpQU14",
+	"SiOq4GvJFaQ0NqqEiOpkDjlDsalUOTO4uLQrzaJAUW0UFzNaVRUK60IKDVbhCUs/wNcStMF/iRQGhP3J",
+	"iiLjCTNcilGh5F0G+X8+aynw3UrdPxVMaUz/MVodauTe6tGFk3JKU9CJ4gVuR2P6M8vQUEiJVISLe5bx",
+	"lChnR0RSMIxnmmRcm/btlEOWalpF9FSKacaTnRqMcSEsU8DSBYFHro015UyqO56mIHZpy6X8AoJkLPmi",
+	"iU5kAWjJL9KcyVKkO3eKkIZMreYqou9fl2b+RimpnrDjefq9LQMmfDg7JUfHh68IuBURvRKsNHOp+G+w",
+	"U2ec1zhNFKQgDGeZRnAbDBbF5fUeqOIMsdy6qVCyAGW4S0iLc/wBjywvMqAxhZzxbDOXI5qD1mwGuHrj",
+	"neWV4BtVZrCVgsonmuvaslp+pfy2lZN3nyExqOJcGCV1AYnxyKV7TueYeDmg1C0b0uEIbVMJSwy/9/1z",
+	"J2UGTKA8PBYdwuTCHB2unMCFgRlYSHFmtlzp0jHkdF3eBZ+bRTHshPoYIS+8kzMuPCezNOWISJZdeJ6Y",
+	"skxDtOacgmn9IFUatAtrjSs7Q8a1K6PVjk9Y2heqFg/dtHr76ZLIKUElgwDtx0qXl7qKoXkMosxxm7ri",
+	"TOp6RCNaCl0WhVQG0slMMWEmVkPULk0yDsJ4DxwQIqpB3YOaOB23QwdYX7Y6QEM88TJAgMf/Gx+TmtCa",
+	"4knXo53IdC3rW+NXjBUiGbdhECSNrnhJuYFcD9Gnx3tVq4kpxRb4nwttmEjC+VMHA9uf0GttmCm198pL",
+	"SsNNBn3Jt+aTpi7o0VbeWQegQ4VT2BoVOd+HwvoBZlwbZUvStyWxo26fnXqrhZ/vOXt8B2Jm5jQ+Poho",
+	"zkXz9zB6mgw8yaPDaHtuaMx6kiM+tmHsnnIV3lWo3v80GI9aLKTJNlG9xcnlcx/Y6rcaEgUmuMIjCY9Y",
+	"ajkfUbcBb7dFpJvpumAJEA1Y2A2krvUbxqRnyhN+6C+gCWg96SvWtopyBXrCA9ytIZEiRRO3KJ1WxWQz",
+	"I0+AqS24v2NoZ7eOjSEXYBsbgIACdPNkrfanzMCe4RbSm87I+5iSp51dwsNZRJXsYarty7HdOJB3duvI",
+	"P9amLxB8kJSKm8VHpOw6+UDd8wSwhG7G+NRiutP1Wt8TrnUJKblbkJHEjnzUhGaayQcvyU5XklaZQ3XT",
+	"EOiYt22ebbKbP06Lrs+qY5zMsBwCS22zoFtMXamMxrRjRLWqPvbxgWvouZjKQIX1OJowkZIMG5mmKdER",
+	"sc3FAUl6PKHJVCpSO1Hv34gRyo3U+rbusducKSBFeZfxJCJmDkQBTsLa7oJDsyCYOUqwjBRSmf0bYauq",
+	"toJ9LQH5V98A9O/oRsBjAoVpDgMiLSQXRhMzZ4YwoR9AkQdu5qQ7cpH2NmH/RrS1L6a4DfnoDk1eX5zT",
+	"iN6D0s6lL/bH+2MMkCxAsILTmL7cH++/tOXBzG3464D54V/SQjq2xkS1xzhPO6i4rDFWdwsnMl08MQM+",
+	"7j08POxhUu6VKgOBZTrdfhjcHHKqqlq/m1m/bzkYj/+w0TgwAQUGU6ypgHC1aIyIFNmCuInCQcpYgHJR",
+	"P6sTq4ro4XjcZ0J7ps58jiIvhkU6I7oVejkstLpq8WmKxtdrBHUdII7b6hYnsDxnatH0zEdHBw1TtQvR",
+	"YRE1bKZxHwtAeovqOuzh4TDIhbgUGSBhBrTLmR8vLy/ICdM8wTuBtruwed/pJggXGI2cRusYRzrdKbw7",
+	"HdKOkd3tSkKgrpml5vPvh9WuyH+fq6XycVdzq0Ndw7B1Qgaqh+3cevC4qhX9tGgH7is3OG+HmOcFqXP1",
+	"sGOAdC8Tei9Jm5uDbQHi3YZ/G5l14v3mMZkzMQPStGM235sByLLu20+XXoRd4+JF2G8S+gPtOhRQ3zHW",
+	"oUF1xyGvZ8PQJXB9/qaafEOgXw2LtJ8dukFulJOk1EbmoJ6K5z0oPl2MlvWXnAq1ziAQ0l/twjqg/iei",
+	"67CZqyWj+hMSlrw/IRao3ftAspuse34LgRKHwxLtV5WhnsMbQtaajdM5JF9cF116vlmBpOnkfZwMA+QH",
+	"MH9ZdFjDerDxNygWTZZgZyjLTs3vgUXVPl7634Ixw9oHrjvwHrQbVLfV7wMA9eOke3UeAAA=",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -1,10 +1,10 @@
 package main
 
 import (
+	"auth/api"
+	"auth/domain"
 	"auth/internal/config"
-	"auth/internal/delivery/rest/login"
-	"auth/internal/delivery/rest/registration"
-	"auth/internal/delivery/rest/verification"
+	"auth/internal/delivery/rest"
 	"auth/internal/kafka"
 	"auth/internal/logger"
 	"auth/internal/repository/postgres/outbox"
@@ -13,12 +13,13 @@ import (
 	"auth/service/auth"
 	outboxworker "auth/service/outbox"
 	"context"
+	"github.com/R1ckNash/Bank/pkg/api/openapi"
 	"github.com/R1ckNash/Bank/pkg/postgres"
+	"github.com/R1ckNash/Bank/pkg/resilience"
 	"github.com/R1ckNash/Bank/pkg/transaction_manager"
-	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/chi/v5/middleware"
 	"go.uber.org/zap"
 	"log"
+	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
@@ -45,6 +46,9 @@ func main() {
 		postgres.WithMaxConnLifeTime(time.Hour),
 		postgres.WithMaxConnectionsCount(10),
 		postgres.WithMinConnectionsCount(5),
+		postgres.WithReplicas(cfg.DBReplicaUrls...),
+		postgres.WithSlowQueryLog(slog.Default(), cfg.DBSlowQuery),
+		postgres.WithTracing(nil),
 	)
 	if err != nil {
 		logg.Fatal("db connection error", zap.Error(err))
@@ -58,6 +62,8 @@ func main() {
 	defer kafkaProducer.Close()
 
 	// repository
+	transaction_manager.RegisterMetrics()
+	resilience.RegisterMetrics()
 	txManager := transaction_manager.New(pool)
 	userRepo := user.New(txManager)
 	outboxRepo := outbox.New(txManager)
@@ -66,6 +72,11 @@ func main() {
 	go outboxWorker.Run(ctx)
 
 	// services
+	serviceClients := make(map[string]domain.ServiceClient, len(cfg.ServiceAuth.Clients))
+	for _, c := range cfg.ServiceAuth.Clients {
+		serviceClients[c.ID] = domain.ServiceClient{ID: c.ID, Secret: c.Secret, Scopes: c.Scopes}
+	}
+
 	authService := auth.NewAuthService(auth.Deps{
 		UserRepository:     userRepo,
 		OutboxRepository:   outboxRepo,
@@ -73,26 +84,28 @@ func main() {
 		Producer:           kafkaProducer,
 		JwtSecret:          cfg.JWTSecret,
 		Logger:             logg,
+		ServiceClients:     serviceClients,
+		ServiceSecret:      cfg.ServiceAuth.Secret,
+		ServiceTokenTTL:    cfg.ServiceAuth.TokenTTL,
 	})
 
 	// delivery
-	r := chi.NewRouter()
-	r.Use(
-		middleware.Recoverer,
-		middleware.Logger,
-		middleware.Heartbeat("/ping"),
-		middleware.RequestID,
-		middleware.URLFormat,
-		middleware.Timeout(5*time.Second),
-	)
+	rest.RegisterProblems()
 
-	r.Route("/user", func(r chi.Router) {
-		r.Post("/registration", registration.New(logg, authService))
-		r.Post("/login", login.New(logg, authService))
-		r.Get("/verify/{user_id}", verification.New(logg, authService))
-	})
+	spec, err := api.GetSwagger()
+	if err != nil {
+		logg.Fatal("cannot load openapi spec", zap.Error(err))
+	}
+
+	apiServer := api.ServerInterfaceWrapper{
+		Handler:          rest.NewServer(logg, authService),
+		ErrorHandlerFunc: openapi.ParamErrorHandler,
+	}
+
+	r, ir := routers(spec, apiServer, cfg.ServiceAuth.Secret)
 
 	application := server.New(logg, r, cfg.Port)
+	internalApplication := server.New(logg, ir, cfg.InternalPort)
 
 	// graceful shutdown
 	go func() {
@@ -101,6 +114,12 @@ func main() {
 		}
 	}()
 
+	go func() {
+		if err := internalApplication.Run(); err != nil {
+			logg.Fatal("internal server error", zap.Error(err))
+		}
+	}()
+
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
 
@@ -113,5 +132,9 @@ func main() {
 		logg.Fatal("shutdown error", zap.Error(err))
 	}
 
+	if err := internalApplication.Stop(ctxWithTimeout); err != nil {
+		logg.Fatal("internal shutdown error", zap.Error(err))
+	}
+
 	logg.Info("server stopped")
 }
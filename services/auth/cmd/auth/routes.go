@@ -0,0 +1,60 @@
+package main
+
+import (
+	"auth/api"
+	"github.com/R1ckNash/Bank/pkg/api/openapi"
+	pkgauth "github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"time"
+)
+
+// routers - public router with docs of spec and internal router for other services,
+// their routes are checked against operations of spec in routes_test.go
+func routers(spec *openapi3.T, apiServer api.ServerInterfaceWrapper, serviceSecret string) (chi.Router, chi.Router) {
+	r := chi.NewRouter()
+	r.Use(
+		middleware.Recoverer,
+		middleware.Logger,
+		middleware.Heartbeat("/ping"),
+		middleware.RequestID,
+		middleware.Timeout(5*time.Second),
+	)
+
+	openapi.Mount(r, spec)
+
+	r.With(middleware.URLFormat).Route("/user", func(r chi.Router) {
+		r.Post("/registration", apiServer.RegisterUser)
+		r.Post("/login", apiServer.LoginUser)
+	})
+
+	// internal delivery, only for other services
+	ir := chi.NewRouter()
+	ir.Use(
+		middleware.Recoverer,
+		middleware.Logger,
+		middleware.Heartbeat("/ping"),
+		middleware.RequestID,
+		middleware.Timeout(5*time.Second),
+	)
+
+	ir.Post("/oauth/token", apiServer.IssueToken)
+	ir.With(
+		pkgauth.ServiceAuthMiddleware(serviceSecret),
+		pkgauth.RequireScope("tokens:introspect"),
+	).Post("/oauth/introspect", apiServer.IntrospectToken)
+	ir.Route("/user", func(r chi.Router) {
+		r.Use(
+			pkgauth.ServiceAuthMiddleware(serviceSecret),
+			pkgauth.RequireScope("users:read"),
+		)
+		r.Get("/verify/{user_id}", apiServer.VerifyUser)
+		r.Get("/{user_id}", apiServer.GetUser)
+	})
+
+	ir.Handle("/metrics", promhttp.Handler())
+
+	return r, ir
+}
@@ -8,5 +8,7 @@ var (
 	ErrInvalidPassword = errors.New("invalid password")
 	ErrInvalidToken    = errors.New("invalid token")
 	ErrUnauthorized    = errors.New("unauthorized")
+	ErrInvalidClient   = errors.New("invalid client")
+	ErrInvalidScope    = errors.New("invalid scope")
 	ErrInternal        = errors.New("internal server error")
 )
@@ -0,0 +1,8 @@
+package domain
+
+// ServiceClient is an internal service allowed to get client-credentials tokens
+type ServiceClient struct {
+	ID     string
+	Secret string
+	Scopes []string
+}
@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+// TokenInfo is the result of token introspection (RFC 7662)
+type TokenInfo struct {
+	Active    bool      `json:"active"`
+	Type      string    `json:"typ,omitempty"`
+	Subject   string    `json:"sub,omitempty"`
+	Scope     string    `json:"scope,omitempty"`
+	ExpiresAt time.Time `json:"-"`
+	IssuedAt  time.Time `json:"-"`
+}
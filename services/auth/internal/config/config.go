@@ -4,13 +4,31 @@ import (
 	"github.com/ilyakaznacheev/cleanenv"
 	"log"
 	"os"
+	"time"
 )
 
 type Config struct {
-	Env       string `yaml:"env" env-default:"local" env-required:"true"`
-	DBUrl     string `yaml:"dbUrl" env-required:"true"`
-	Port      int    `yaml:"port" env-default:"8080"`
-	JWTSecret string `yaml:"jwt-secret" env-default:"supersecretkey"`
+	Env           string        `yaml:"env" env-default:"local" env-required:"true"`
+	DBUrl         string        `yaml:"dbUrl" env-required:"true"`
+	DBReplicaUrls []string      `yaml:"dbReplicaUrls" env:"DB_REPLICA_URLS" env-separator:","`
+	DBSlowQuery   time.Duration `yaml:"dbSlowQuery" env-default:"500ms"`
+	Port          int           `yaml:"port" env-default:"8080"`
+	JWTSecret     string        `yaml:"jwt-secret" env-default:"supersecretkey"`
+
+	// InternalPort - port of the router for service-to-service calls, must not be exposed outside
+	InternalPort int `yaml:"internal_port" env-default:"8090"`
+
+	ServiceAuth struct {
+		Secret   string          `yaml:"secret" env-default:"servicesecretkey"`
+		TokenTTL time.Duration   `yaml:"token_ttl" env-default:"15m"`
+		Clients  []ServiceClient `yaml:"clients"`
+	} `yaml:"service_auth"`
+}
+
+type ServiceClient struct {
+	ID     string   `yaml:"id"`
+	Secret string   `yaml:"secret"`
+	Scopes []string `yaml:"scopes"`
 }
 
 func MustLoad() *Config {
@@ -1,8 +1,12 @@
 package login
 
 import (
+	"auth/domain"
 	"context"
+	"errors"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
 	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	"github.com/R1ckNash/Bank/pkg/validation"
 	"github.com/go-chi/render"
 	"go.uber.org/zap"
 	"net/http"
@@ -14,7 +18,7 @@ type UserAuthenticator interface {
 
 type Request struct {
 	Username string `json:"username" validate:"required"`
-	Password string `json:"password" binding:"required,min=4"`
+	Password string `json:"password" validate:"required"`
 }
 
 func New(logger *zap.Logger, userAuthenticator UserAuthenticator) http.HandlerFunc {
@@ -26,17 +30,21 @@ func New(logger *zap.Logger, userAuthenticator UserAuthenticator) http.HandlerFu
 		log := logger.With(zap.String("op", op), zap.String("username", req.Username))
 		log.Info("login user")
 
-		err := render.DecodeJSON(r.Body, &req)
+		err := validation.DecodeJSON(w, r, &req)
 		if err != nil {
 			log.Error("failed to decode request body", zap.Error(err))
-			render.JSON(w, r, resp.Error("failed to decode request"))
+			problem.WriteError(w, r, err)
 			return
 		}
 
 		token, err := userAuthenticator.LoginUser(context.Background(), req.Username, req.Password)
 		if err != nil {
-			log.Error("failed to decode request body", zap.Error(err))
-			render.JSON(w, r, resp.Error("failed to login"))
+			log.Error("failed to login", zap.Error(err))
+			if errors.Is(err, domain.ErrUserNotFound) || errors.Is(err, domain.ErrInvalidPassword) {
+				problem.Write(w, r, problem.InvalidCredentials.New("invalid username or password"))
+				return
+			}
+			problem.WriteError(w, r, err)
 			return
 		}
 
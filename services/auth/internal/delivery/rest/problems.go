@@ -0,0 +1,17 @@
+package rest
+
+import (
+	"auth/domain"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+)
+
+// RegisterProblems - domain errors that handlers don't map themselves are written as these problems
+func RegisterProblems() {
+	problem.Map(domain.ErrUserNotFound, problem.UserNotFound)
+	problem.Map(domain.ErrAlreadyExists, problem.UserAlreadyExists)
+	problem.Map(domain.ErrInvalidPassword, problem.InvalidCredentials)
+	problem.Map(domain.ErrInvalidToken, problem.Unauthorized)
+	problem.Map(domain.ErrUnauthorized, problem.Unauthorized)
+	problem.Map(domain.ErrInvalidClient, problem.Unauthorized)
+	problem.Map(domain.ErrInvalidScope, problem.BadRequest)
+}
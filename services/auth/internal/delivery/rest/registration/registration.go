@@ -3,7 +3,11 @@ package registration
 import (
 	"auth/domain"
 	"context"
+	"errors"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
 	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	pkgauth "github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/R1ckNash/Bank/pkg/validation"
 	"github.com/go-chi/render"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -16,9 +20,9 @@ type UserCreator interface {
 }
 
 type Request struct {
-	Username string `json:"username" validate:"required"`
-	Email    string `json:"email" binding:"required,email"`
-	Password string `json:"password" binding:"required,min=4"`
+	Username string `json:"username" validate:"required,max=64"`
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required,min=4,max=72"`
 }
 
 func New(logger *zap.Logger, userCreator UserCreator) http.HandlerFunc {
@@ -30,10 +34,10 @@ func New(logger *zap.Logger, userCreator UserCreator) http.HandlerFunc {
 		log := logger.With(zap.String("op", op), zap.String("username", req.Username))
 		log.Info("registration new user")
 
-		err := render.DecodeJSON(r.Body, &req)
+		err := validation.DecodeJSON(w, r, &req)
 		if err != nil {
 			log.Error("failed to decode request body", zap.Error(err))
-			render.JSON(w, r, resp.Error("failed to decode request"))
+			problem.WriteError(w, r, err)
 			return
 		}
 
@@ -42,13 +46,18 @@ func New(logger *zap.Logger, userCreator UserCreator) http.HandlerFunc {
 			Username:  req.Username,
 			Email:     req.Email,
 			Password:  req.Password,
+			Role:      pkgauth.RoleCustomer,
 			CreatedAt: time.Now(),
 		}
 
 		err = userCreator.RegisterUser(context.Background(), user)
 		if err != nil {
 			log.Error("failed to register user", zap.Error(err))
-			render.JSON(w, r, resp.Error("failed to register user"))
+			if errors.Is(err, domain.ErrAlreadyExists) {
+				problem.Write(w, r, problem.UserAlreadyExists.New("user already exists"))
+				return
+			}
+			problem.WriteError(w, r, err)
 			return
 		}
 
@@ -0,0 +1,66 @@
+package rest
+
+import (
+	"auth/api"
+	"auth/internal/delivery/rest/login"
+	"auth/internal/delivery/rest/registration"
+	"auth/internal/delivery/rest/token"
+	"auth/internal/delivery/rest/user"
+	"auth/internal/delivery/rest/verification"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+// AuthService - what handlers need from auth service
+type AuthService interface {
+	registration.UserCreator
+	login.UserAuthenticator
+	token.ServiceTokenIssuer
+	token.TokenIntrospector
+	verification.UserVerificator
+	user.UserGetter
+}
+
+// Server - implements api.ServerInterface generated from api/openapi.yaml with handlers of service.
+// Params are bound by generated wrapper and passed to handlers, which validate them by validate tags
+type Server struct {
+	register, login, issueToken, introspect http.HandlerFunc
+	verify, getUser                         func(http.ResponseWriter, *http.Request, api.UserID)
+}
+
+var _ api.ServerInterface = (*Server)(nil)
+
+func NewServer(logger *zap.Logger, authService AuthService) *Server {
+	return &Server{
+		register:   registration.New(logger, authService),
+		login:      login.New(logger, authService),
+		issueToken: token.New(logger, authService),
+		introspect: token.NewIntrospect(logger, authService),
+		verify:     verification.New(logger, authService),
+		getUser:    user.New(logger, authService),
+	}
+}
+
+func (s *Server) RegisterUser(w http.ResponseWriter, r *http.Request) {
+	s.register(w, r)
+}
+
+func (s *Server) LoginUser(w http.ResponseWriter, r *http.Request) {
+	s.login(w, r)
+}
+
+func (s *Server) IssueToken(w http.ResponseWriter, r *http.Request) {
+	s.issueToken(w, r)
+}
+
+func (s *Server) IntrospectToken(w http.ResponseWriter, r *http.Request) {
+	s.introspect(w, r)
+}
+
+func (s *Server) VerifyUser(w http.ResponseWriter, r *http.Request, userID api.UserID) {
+	s.verify(w, r, userID)
+}
+
+func (s *Server) GetUser(w http.ResponseWriter, r *http.Request, userID api.UserID) {
+	s.getUser(w, r, userID)
+}
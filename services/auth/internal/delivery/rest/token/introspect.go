@@ -0,0 +1,59 @@
+package token
+
+import (
+	"auth/domain"
+	"context"
+	"github.com/go-chi/render"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+type TokenIntrospector interface {
+	IntrospectToken(ctx context.Context, token string) domain.TokenInfo
+}
+
+// IntrospectResponse - RFC 7662 introspection response
+type IntrospectResponse struct {
+	Active bool   `json:"active"`
+	Type   string `json:"typ,omitempty"`
+	Sub    string `json:"sub,omitempty"`
+	Scope  string `json:"scope,omitempty"`
+	Exp    int64  `json:"exp,omitempty"`
+	Iat    int64  `json:"iat,omitempty"`
+}
+
+// NewIntrospect - token introspection endpoint
+func NewIntrospect(logger *zap.Logger, introspector TokenIntrospector) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.token.NewIntrospect"
+
+		log := logger.With(zap.String("op", op))
+
+		if err := r.ParseForm(); err != nil {
+			log.Error("failed to parse form", zap.Error(err))
+			responseError(w, r, http.StatusBadRequest, "invalid_request")
+			return
+		}
+
+		tokenString := r.PostForm.Get("token")
+		if tokenString == "" {
+			responseError(w, r, http.StatusBadRequest, "invalid_request")
+			return
+		}
+
+		info := introspector.IntrospectToken(r.Context(), tokenString)
+		if !info.Active {
+			render.JSON(w, r, IntrospectResponse{Active: false})
+			return
+		}
+
+		render.JSON(w, r, IntrospectResponse{
+			Active: true,
+			Type:   info.Type,
+			Sub:    info.Subject,
+			Scope:  info.Scope,
+			Exp:    info.ExpiresAt.Unix(),
+			Iat:    info.IssuedAt.Unix(),
+		})
+	}
+}
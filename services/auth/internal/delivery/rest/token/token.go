@@ -0,0 +1,79 @@
+package token
+
+import (
+	"auth/domain"
+	"context"
+	"errors"
+	"github.com/go-chi/render"
+	"go.uber.org/zap"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const grantTypeClientCredentials = "client_credentials"
+
+type ServiceTokenIssuer interface {
+	IssueServiceToken(ctx context.Context, clientID, clientSecret string, scopes []string) (string, time.Duration, error)
+}
+
+type Response struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// errorResponse - RFC 6749 error response
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// New - OAuth2 token endpoint, supports only client credentials grant
+func New(logger *zap.Logger, issuer ServiceTokenIssuer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.token.New"
+
+		log := logger.With(zap.String("op", op))
+
+		if err := r.ParseForm(); err != nil {
+			log.Error("failed to parse form", zap.Error(err))
+			responseError(w, r, http.StatusBadRequest, "invalid_request")
+			return
+		}
+
+		if r.PostForm.Get("grant_type") != grantTypeClientCredentials {
+			responseError(w, r, http.StatusBadRequest, "unsupported_grant_type")
+			return
+		}
+
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok {
+			clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+		}
+
+		token, ttl, err := issuer.IssueServiceToken(r.Context(), clientID, clientSecret, strings.Fields(r.PostForm.Get("scope")))
+		if err != nil {
+			log.Error("failed to issue token", zap.Error(err), zap.String("client_id", clientID))
+			switch {
+			case errors.Is(err, domain.ErrInvalidClient):
+				responseError(w, r, http.StatusUnauthorized, "invalid_client")
+			case errors.Is(err, domain.ErrInvalidScope):
+				responseError(w, r, http.StatusBadRequest, "invalid_scope")
+			default:
+				responseError(w, r, http.StatusInternalServerError, "server_error")
+			}
+			return
+		}
+
+		render.JSON(w, r, Response{
+			AccessToken: token,
+			TokenType:   "Bearer",
+			ExpiresIn:   int64(ttl.Seconds()),
+		})
+	}
+}
+
+func responseError(w http.ResponseWriter, r *http.Request, status int, code string) {
+	render.Status(r, status)
+	render.JSON(w, r, errorResponse{Error: code})
+}
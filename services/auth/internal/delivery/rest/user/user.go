@@ -0,0 +1,65 @@
+package user
+
+import (
+	"auth/api"
+	"auth/domain"
+	"context"
+	"errors"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+type UserGetter interface {
+	GetUser(ctx context.Context, id uuid.UUID) (*domain.User, error)
+}
+
+type Params struct {
+	UserID uuid.UUID `path:"user_id" validate:"required"`
+}
+
+// Response - user without credentials
+type Response struct {
+	ID        uuid.UUID `json:"id"`
+	Username  string    `json:"username"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func New(logger *zap.Logger, userGetter UserGetter) func(http.ResponseWriter, *http.Request, api.UserID) {
+	return func(w http.ResponseWriter, r *http.Request, userID api.UserID) {
+		const op = "handler.user.New"
+
+		log := logger.With(zap.String("op", op))
+
+		params := Params{UserID: userID}
+		if err := validation.Params(&params); err != nil {
+			problem.WriteError(w, r, err)
+			return
+		}
+
+		user, err := userGetter.GetUser(r.Context(), params.UserID)
+		if err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				problem.Write(w, r, problem.UserNotFound.New("user not found"))
+				return
+			}
+			log.Error("failed to get user", zap.Error(err))
+			problem.WriteError(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, Response{
+			ID:        user.ID,
+			Username:  user.Username,
+			Email:     user.Email,
+			Role:      user.Role,
+			CreatedAt: user.CreatedAt,
+		})
+	}
+}
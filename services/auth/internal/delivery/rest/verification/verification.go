@@ -1,9 +1,11 @@
 package verification
 
 import (
+	"auth/api"
 	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
 	resp "github.com/R1ckNash/Bank/pkg/api/response"
-	"github.com/go-chi/chi/v5"
+	"github.com/R1ckNash/Bank/pkg/validation"
 	"github.com/go-chi/render"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -14,22 +16,21 @@ type UserVerificator interface {
 	VerifyUser(ctx context.Context, id uuid.UUID) bool
 }
 
-type Request struct {
-	ID string `json:"id" validate:"required"`
+type Params struct {
+	UserID uuid.UUID `path:"user_id" validate:"required"`
 }
 
-func New(logger *zap.Logger, userVerificator UserVerificator) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		userIDStr := chi.URLParam(r, "user_id")
-		userUUID, err := uuid.Parse(userIDStr)
-		if err != nil {
-			http.Error(w, `{"error": "invalid user_id"}`, http.StatusBadRequest)
+func New(logger *zap.Logger, userVerificator UserVerificator) func(http.ResponseWriter, *http.Request, api.UserID) {
+	return func(w http.ResponseWriter, r *http.Request, userID api.UserID) {
+		params := Params{UserID: userID}
+		if err := validation.Params(&params); err != nil {
+			problem.WriteError(w, r, err)
 			return
 		}
 
-		isExist := userVerificator.VerifyUser(r.Context(), userUUID)
+		isExist := userVerificator.VerifyUser(r.Context(), params.UserID)
 		if !isExist {
-			http.Error(w, `{"error": "user not found"}`, http.StatusNotFound)
+			problem.Write(w, r, problem.UserNotFound.New("user not found"))
 			return
 		}
 
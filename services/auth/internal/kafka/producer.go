@@ -1,22 +1,17 @@
 package kafka
 
 import (
-	"github.com/IBM/sarama"
+	pkgkafka "github.com/R1ckNash/Bank/pkg/kafka"
 	"go.uber.org/zap"
 )
 
 type Producer struct {
-	producer sarama.SyncProducer
+	producer *pkgkafka.Producer
 	logger   *zap.Logger
 }
 
 func NewProducer(brokers []string, logger *zap.Logger) (*Producer, error) {
-	config := sarama.NewConfig()
-	config.Producer.RequiredAcks = sarama.WaitForAll
-	config.Producer.Return.Successes = true
-	config.Producer.Partitioner = sarama.NewHashPartitioner
-
-	prod, err := sarama.NewSyncProducer(brokers, config)
+	prod, err := pkgkafka.NewProducer(brokers)
 	if err != nil {
 		return nil, err
 	}
@@ -25,13 +20,7 @@ func NewProducer(brokers []string, logger *zap.Logger) (*Producer, error) {
 }
 
 func (p *Producer) SendMessage(topic, key string, message []byte) error {
-	msg := &sarama.ProducerMessage{
-		Topic: topic,
-		Key:   sarama.StringEncoder(key),
-		Value: sarama.ByteEncoder(message),
-	}
-
-	partition, offset, err := p.producer.SendMessage(msg)
+	partition, offset, err := p.producer.Send(topic, key, message)
 	if err != nil {
 		p.logger.Error("failed to send message", zap.Error(err))
 		return err
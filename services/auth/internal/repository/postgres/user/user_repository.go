@@ -43,6 +43,7 @@ func (s *UserRepository) fetch(ctx context.Context, query string, args ...interf
 			&t.Username,
 			&t.Email,
 			&t.Password,
+			&t.Role,
 			&t.CreatedAt,
 		)
 
@@ -57,9 +58,9 @@ func (s *UserRepository) fetch(ctx context.Context, query string, args ...interf
 func (s *UserRepository) StoreUser(ctx context.Context, user *domain.User) error {
 	const api = "postgres.StoreUser"
 
-	query := `insert into users (id, username, email, password, created_at) values ($1, $2, $3, $4, $5) returning id`
+	query := `insert into users (id, username, email, password, role, created_at) values ($1, $2, $3, $4, $5, $6) returning id`
 
-	if _, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, user.ID, user.Username, user.Email, user.Password, user.CreatedAt); err != nil {
+	if _, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, user.ID, user.Username, user.Email, user.Password, user.Role, user.CreatedAt); err != nil {
 		var pgError *pgconn.PgError
 		if errors.As(err, &pgError) && pgError.Code == pgerrcode.UniqueViolation {
 			return pkgerrors.Wrap(api, domain.ErrAlreadyExists)
@@ -72,7 +73,7 @@ func (s *UserRepository) StoreUser(ctx context.Context, user *domain.User) error
 
 func (s *UserRepository) GetByID(ctx context.Context, userID uuid.UUID) (res *domain.User, err error) {
 	const api = "postgres.GetByID"
-	query := `SELECT id, username, email, password, created_at FROM users WHERE id=$1`
+	query := `SELECT id, username, email, password, role, created_at FROM users WHERE id=$1`
 	list, err := s.fetch(ctx, query, userID)
 	if err != nil {
 		return nil, pkgerrors.Wrap(api, err)
@@ -90,7 +91,7 @@ func (s *UserRepository) GetByID(ctx context.Context, userID uuid.UUID) (res *do
 func (s *UserRepository) GetByUsername(ctx context.Context, username string) (res *domain.User, err error) {
 	const api = "postgres.GetByUsername"
 
-	query := `select id, username, email, password, created_at from users where username=$1`
+	query := `select id, username, email, password, role, created_at from users where username=$1`
 	list, err := s.fetch(ctx, query, username)
 	if err != nil {
 		return nil, pkgerrors.Wrap(api, err)
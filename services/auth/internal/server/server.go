@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
 	"go.uber.org/zap"
@@ -32,7 +33,11 @@ func (s *Server) Run() error {
 	}
 
 	s.log.Info("HTTP server started", zap.String("addr", l.Addr().String()))
-	return s.httpServer.Serve(l)
+	if err := s.httpServer.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
 }
 
 func (s *Server) Stop(ctx context.Context) error {
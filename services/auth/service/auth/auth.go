@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
-	"github.com/R1ckNash/Bank/pkg/helpers"
+	"github.com/R1ckNash/Bank/pkg/retry"
 	"github.com/R1ckNash/Bank/pkg/transaction_manager"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
@@ -20,6 +20,9 @@ type AuthService interface {
 	RegisterUser(ctx context.Context, user *domain.User) error
 	LoginUser(ctx context.Context, username, password string) (string, error)
 	VerifyUser(ctx context.Context, id uuid.UUID) bool
+	GetUser(ctx context.Context, id uuid.UUID) (*domain.User, error)
+	IntrospectToken(ctx context.Context, token string) domain.TokenInfo
+	IssueServiceToken(ctx context.Context, clientID, clientSecret string, scopes []string) (string, time.Duration, error)
 }
 
 //go:generate mockery --name=UserRepository --filename=user_repository_mock.go --disable-version-string
@@ -53,6 +56,11 @@ type Deps struct {
 	Producer  EventProducer
 	JwtSecret string
 	Logger    *zap.Logger
+
+	// service-to-service (client credentials) tokens
+	ServiceClients  map[string]domain.ServiceClient
+	ServiceSecret   string
+	ServiceTokenTTL time.Duration
 }
 
 type authService struct {
@@ -84,7 +92,7 @@ func (as *authService) RegisterUser(ctx context.Context, user *domain.User) erro
 	user.Password = string(hashed)
 	eventPayload := fmt.Sprintf(`{"event_type":"registration","user_id":"%s","email":"%s","status":"success"}`, user.ID, user.Email)
 
-	err = helpers.WithRetries(ctx, func(ctx context.Context) error {
+	err = retry.Do(ctx, retry.DefaultPolicy(), func(ctx context.Context) error {
 		var err error
 		err = as.TransactionManager.RunReadCommitted(ctx, transaction_manager.ReadWrite,
 			func(txCtx context.Context) error { // TRANSANCTION SCOPE
@@ -139,6 +147,7 @@ func (as *authService) LoginUser(ctx context.Context, username, password string)
 	// Generate JWT
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
 		"user_id": user.ID,
+		"role":    user.Role,
 		"exp":     time.Now().Add(time.Hour * 24).Unix(),
 		"iat":     time.Now().Unix(),
 	})
@@ -168,3 +177,20 @@ func (as *authService) VerifyUser(ctx context.Context, id uuid.UUID) bool {
 
 	return true
 }
+
+// GetUser - user by id
+func (as *authService) GetUser(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	const api = "auth.GetUser"
+
+	log := as.Logger.With(zap.String("op", api), zap.String("id", id.String()))
+
+	log.Info("get user")
+
+	user, err := as.UserRepository.GetByID(ctx, id)
+	if err != nil {
+		log.Error("failed to get user", zap.Error(err))
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return user, nil
+}
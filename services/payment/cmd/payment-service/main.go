@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	accountclient "github.com/R1ckNash/Bank/pkg/client/account"
+	"github.com/R1ckNash/Bank/pkg/httpclient"
+	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/R1ckNash/Bank/pkg/postgres"
+	"github.com/R1ckNash/Bank/pkg/resilience"
+	"github.com/R1ckNash/Bank/pkg/transaction_manager"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"os"
+	"os/signal"
+	"payment/internal/config"
+	"payment/internal/http-server/handlers"
+	"payment/internal/http-server/handlers/cancel_scheduled_handler"
+	"payment/internal/http-server/handlers/create_beneficiary_handler"
+	"payment/internal/http-server/handlers/create_payment_handler"
+	"payment/internal/http-server/handlers/create_scheduled_handler"
+	"payment/internal/http-server/handlers/delete_beneficiary_handler"
+	"payment/internal/http-server/handlers/get_beneficiary_handler"
+	"payment/internal/http-server/handlers/get_payment_handler"
+	"payment/internal/http-server/handlers/get_scheduled_handler"
+	"payment/internal/http-server/handlers/list_beneficiaries_handler"
+	"payment/internal/http-server/handlers/list_executions_handler"
+	"payment/internal/http-server/handlers/list_payments_handler"
+	"payment/internal/http-server/handlers/list_reviews_handler"
+	"payment/internal/http-server/handlers/list_scheduled_handler"
+	"payment/internal/http-server/handlers/review_handler"
+	"payment/internal/http-server/handlers/update_beneficiary_handler"
+	http_server "payment/internal/http-server/server"
+	"payment/internal/kafka"
+	httpdelivery "payment/internal/middleware"
+	"payment/internal/models"
+	"payment/internal/repository/payment_storage"
+	"payment/internal/services/payment"
+	"payment/internal/services/risk"
+	"syscall"
+	"time"
+)
+
+const (
+	envDev  = "dev"
+	envProd = "prod"
+)
+
+func main() {
+
+	parent := context.Background()
+	cfg := config.MustLoad()
+	log := setupLogger(cfg.Env)
+
+	log.Info("starting payment-service with", slog.String("env", cfg.Env))
+
+	// repository
+	pool, err := postgres.NewConnectionPool(parent, cfg.DBUrl,
+		postgres.WithMaxConnIdleTime(5*time.Minute),
+		postgres.WithMaxConnLifeTime(time.Hour),
+		postgres.WithMaxConnectionsCount(10),
+		postgres.WithMinConnectionsCount(5),
+		postgres.WithReplicas(cfg.DBReplicaUrls...),
+		postgres.WithSlowQueryLog(log, cfg.DBSlowQuery),
+		postgres.WithTracing(nil),
+		postgres.WithMetrics(prometheus.DefaultRegisterer),
+	)
+	if err != nil {
+		panic("db connection error")
+	}
+
+	// kafka
+	producer, err := kafka.NewProducer([]string{cfg.Kafka.Host}, log)
+	if err != nil {
+		panic("could not create kafka producer")
+	}
+	defer producer.Close()
+
+	// account service client, attaches client-credentials token of payment-service
+	authURL := fmt.Sprintf("http://%s:%d", cfg.AuthService.Host, cfg.AuthService.Port)
+	accountHTTPClient := httpclient.New(
+		httpclient.WithTimeout(5*time.Second),
+		httpclient.WithTokenSource(httpclient.NewClientCredentials(authURL+"/oauth/token", cfg.AuthService.ClientID, cfg.AuthService.ClientSecret,
+			accountclient.ScopeAccountsRead, accountclient.ScopeTransfersWrite)),
+	)
+	accountURL := fmt.Sprintf("http://%s:%d", cfg.AccountService.Host, cfg.AccountService.Port)
+	accountClient := accountclient.New(accountURL, accountHTTPClient, accountclient.WithTimeout(cfg.AccountService.Timeout))
+
+	transaction_manager.RegisterMetrics()
+	resilience.RegisterMetrics()
+	handlers.RegisterProblems()
+	txManager := transaction_manager.New(pool)
+	storage := payment_storage.New(txManager)
+
+	riskRules, err := risk.LoadRules(cfg.Risk.RulesFile)
+	if err != nil {
+		panic("invalid risk rules: " + err.Error())
+	}
+
+	coolingOffAmount, err := decimal.NewFromString(cfg.Beneficiaries.LargeAmount)
+	if err != nil {
+		panic("invalid beneficiaries large_amount: " + err.Error())
+	}
+
+	paymentService := payment.NewPaymentService(payment.Deps{
+		PaymentStorage:     storage,
+		TransactionManager: txManager,
+		EventProducer:      producer,
+		AccountClient:      accountClient,
+		RiskEvaluator:      risk.NewEngine(riskRules, storage),
+		Logger:             log,
+		RetryDelay:         cfg.Scheduler.RetryDelay,
+		DefaultMaxRetries:  cfg.Scheduler.MaxRetries,
+		Lease:              cfg.Scheduler.Lease,
+		CoolingOff:         cfg.Beneficiaries.CoolingOff,
+		CoolingOffAmount:   coolingOffAmount,
+	})
+
+	router := chi.NewRouter()
+
+	router.Use(
+		middleware.Recoverer,
+		middleware.Logger,
+		httpdelivery.PrometheusMiddleware,
+		middleware.Heartbeat("/ping"),
+		middleware.RequestID,
+		middleware.URLFormat,
+		auth.AuthMiddleware(cfg.JWTSecret),
+	)
+
+	router.Route("/payments", func(r chi.Router) {
+		r.Get("/", list_payments_handler.New(log, paymentService))
+		r.Post("/", create_payment_handler.New(log, paymentService))
+		r.Get("/{paymentID}", get_payment_handler.New(log, paymentService))
+
+		// operators
+		r.Route("/reviews", func(r chi.Router) {
+			r.Use(auth.RequireRole(auth.RoleOperator))
+			r.Get("/", list_reviews_handler.New(log, paymentService))
+			r.Post("/{paymentID}/approve", review_handler.New(log, paymentService, models.ReviewApprove))
+			r.Post("/{paymentID}/reject", review_handler.New(log, paymentService, models.ReviewReject))
+		})
+
+		r.Route("/beneficiaries", func(r chi.Router) {
+			r.Get("/", list_beneficiaries_handler.New(log, paymentService))
+			r.Post("/", create_beneficiary_handler.New(log, paymentService))
+			r.Get("/{beneficiaryID}", get_beneficiary_handler.New(log, paymentService))
+			r.Patch("/{beneficiaryID}", update_beneficiary_handler.New(log, paymentService))
+			r.Delete("/{beneficiaryID}", delete_beneficiary_handler.New(log, paymentService))
+		})
+
+		r.Route("/scheduled", func(r chi.Router) {
+			r.Get("/", list_scheduled_handler.New(log, paymentService))
+			r.Post("/", create_scheduled_handler.New(log, paymentService))
+			r.Get("/{scheduleID}", get_scheduled_handler.New(log, paymentService))
+			r.Get("/{scheduleID}/executions", list_executions_handler.New(log, paymentService))
+			r.Post("/{scheduleID}/cancel", cancel_scheduled_handler.New(log, paymentService))
+		})
+	})
+
+	router.Handle("/metrics", promhttp.Handler())
+
+	server := http_server.New(log, router, cfg.Port)
+
+	ctx, stop := signal.NotifyContext(parent,
+		syscall.SIGINT,
+		syscall.SIGTERM,
+	)
+	defer stop()
+
+	go payment.NewScheduler(paymentService, log, cfg.Scheduler.Interval, cfg.Scheduler.Batch).Run(ctx)
+
+	run(ctx, log, server)
+}
+
+func run(ctx context.Context, log *slog.Logger, servers ...*http_server.Server) error {
+	// Start HTTP servers in goroutines
+	for _, server := range servers {
+		go server.MustRun()
+	}
+
+	// Wait until we receive a shutdown signal
+	<-ctx.Done()
+
+	log.Info("server: shutting down server gracefully")
+
+	// Create a context with a 20-second timeout
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	// Attempt a graceful shutdown
+	for _, server := range servers {
+		if err := server.Stop(shutdownCtx); err != nil {
+			return fmt.Errorf("server: shutdown: %w", err)
+		}
+	}
+
+	log.Info("server: shutdown")
+	return nil
+}
+
+func setupLogger(env string) *slog.Logger {
+	var log *slog.Logger
+
+	switch env {
+	case envDev:
+		log = slog.New(
+			slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+		)
+	case envProd:
+		log = slog.New(
+			slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}),
+		)
+	}
+
+	return log
+}
@@ -0,0 +1,77 @@
+package config
+
+import (
+	"github.com/ilyakaznacheev/cleanenv"
+	"log"
+	"os"
+	"time"
+)
+
+type Config struct {
+	Env           string        `yaml:"env" env-default:"local" env-required:"true"`
+	DBUrl         string        `yaml:"dbUrl" env-required:"true"`
+	DBReplicaUrls []string      `yaml:"dbReplicaUrls" env:"DB_REPLICA_URLS" env-separator:","`
+	DBSlowQuery   time.Duration `yaml:"dbSlowQuery" env-default:"500ms"`
+	Port          int           `yaml:"port" env-default:"8083"`
+	JWTSecret     string        `yaml:"jwt-secret" env-default:"supersecretkey"`
+
+	// Scheduler - worker executing due scheduled payments; insufficient funds are retried after retry_delay
+	// up to max_retries times (default for payments created without explicit value), occurrence being transferred
+	// isn't claimed again for lease
+	Scheduler struct {
+		Interval   time.Duration `yaml:"interval" env-default:"1m"`
+		Batch      int           `yaml:"batch" env-default:"50"`
+		RetryDelay time.Duration `yaml:"retry_delay" env-default:"1h"`
+		MaxRetries int           `yaml:"max_retries" env-default:"3"`
+		Lease      time.Duration `yaml:"lease" env-default:"1m"`
+	} `yaml:"scheduler"`
+
+	// Risk - pre-authorization checks of payments, see config/fraud_rules.yaml for format of rules
+	Risk struct {
+		RulesFile string `yaml:"rules_file" env:"RISK_RULES_FILE" env-default:"/config/fraud_rules.yaml"`
+	} `yaml:"risk"`
+
+	// Beneficiaries - payments of large_amount or more to beneficiary are not allowed for cooling_off after it's added
+	Beneficiaries struct {
+		CoolingOff  time.Duration `yaml:"cooling_off" env-default:"24h"`
+		LargeAmount string        `yaml:"large_amount" env-default:"1000"`
+	} `yaml:"beneficiaries"`
+
+	// AuthService - internal (service-to-service) endpoint of auth service, issues token for account service
+	AuthService struct {
+		Host         string `yaml:"host" env-default:"bank-auth-service"`
+		Port         int    `yaml:"port" env-default:"8090"`
+		ClientID     string `yaml:"client_id" env-default:"payment-service"`
+		ClientSecret string `yaml:"client_secret" env:"AUTH_CLIENT_SECRET"`
+	} `yaml:"auth_service"`
+
+	// AccountService - internal endpoint of account service
+	AccountService struct {
+		Host    string        `yaml:"host" env-default:"bank-account-service"`
+		Port    int           `yaml:"port" env-default:"8092"`
+		Timeout time.Duration `yaml:"timeout" env-default:"10s"`
+	} `yaml:"account_service"`
+
+	Kafka struct {
+		Host string `yaml:"host"`
+	} `yaml:"kafka"`
+}
+
+func MustLoad() *Config {
+	configPath := os.Getenv("CONFIG_PATH")
+	if configPath == "" {
+		log.Fatal("Config path could not be empty")
+	}
+
+	if _, err := os.Stat(configPath); os.IsNotExist(err) {
+		log.Fatalf("config file does not exists: %s", configPath)
+	}
+
+	var cfg Config
+
+	if err := cleanenv.ReadConfig(configPath, &cfg); err != nil {
+		log.Fatalf("could not read config: %s", err)
+	}
+
+	return &cfg
+}
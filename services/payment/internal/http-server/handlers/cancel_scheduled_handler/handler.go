@@ -0,0 +1,68 @@
+package cancel_scheduled_handler
+
+import (
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+	"payment/internal/models"
+	slog_helper "payment/internal/slog"
+)
+
+type Path struct {
+	ScheduleID uuid.UUID `path:"scheduleID" validate:"required"`
+}
+
+type ScheduledPaymentCanceller interface {
+	CancelScheduledPayment(ctx context.Context, id uuid.UUID, ownerID uuid.UUID) (models.ScheduledPayment, error)
+}
+
+// New - cancels future occurrences of scheduled payment of authenticated user
+func New(log *slog.Logger, canceller ScheduledPaymentCanceller) http.HandlerFunc {
+	return func(writer http.ResponseWriter, r *http.Request) {
+		const op = "handlers.scheduled.cancel.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		userID, ok := auth.GetUserID(r)
+		if !ok {
+			problem.Write(writer, r, problem.Unauthorized.New(""))
+			return
+		}
+
+		ownerID, err := uuid.Parse(userID)
+		if err != nil {
+			log.Error("Failed to parse user id")
+			problem.Write(writer, r, problem.BadRequest.New("failed to parse user id"))
+			return
+		}
+
+		var path Path
+		if err := validation.Path(r, &path); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+		id := path.ScheduleID
+
+		payment, err := canceller.CancelScheduledPayment(r.Context(), id, ownerID)
+		if err != nil {
+			log.Error("failed to cancel scheduled payment", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.JSON(writer, r, resp.OKWithData(map[string]interface{}{
+			"status":            resp.StatusOK,
+			"scheduled_payment": payment,
+		}))
+	}
+}
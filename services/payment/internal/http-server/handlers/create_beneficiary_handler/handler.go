@@ -0,0 +1,83 @@
+package create_beneficiary_handler
+
+import (
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	"github.com/R1ckNash/Bank/pkg/iban"
+	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+	"payment/internal/models"
+	slog_helper "payment/internal/slog"
+)
+
+type BeneficiaryCreator interface {
+	CreateBeneficiary(ctx context.Context, req models.CreateBeneficiaryRequest) (models.Beneficiary, error)
+}
+
+type Request struct {
+	Name          string `json:"name" validate:"required,max=128"`
+	Nickname      string `json:"nickname" validate:"max=64"`
+	AccountNumber string `json:"account_number" validate:"required,iban"`
+}
+
+// New - saves beneficiary of authenticated user, response contains result of name verification
+func New(log *slog.Logger, creator BeneficiaryCreator) http.HandlerFunc {
+	return func(writer http.ResponseWriter, r *http.Request) {
+		const op = "handlers.beneficiary.create.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		userID, ok := auth.GetUserID(r)
+		if !ok {
+			problem.Write(writer, r, problem.Unauthorized.New(""))
+			return
+		}
+
+		ownerID, err := uuid.Parse(userID)
+		if err != nil {
+			log.Error("Failed to parse user id")
+			problem.Write(writer, r, problem.BadRequest.New("failed to parse user id"))
+			return
+		}
+
+		var req Request
+		if err = validation.DecodeJSON(writer, r, &req); err != nil {
+			log.Error("Failed to decode body", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		number := iban.Normalize(req.AccountNumber)
+		if iban.Validate(number) != nil {
+			problem.Write(writer, r, problem.BadRequest.New("incorrect account number"))
+			return
+		}
+
+		beneficiary, err := creator.CreateBeneficiary(r.Context(), models.CreateBeneficiaryRequest{
+			OwnerID:       ownerID,
+			Name:          req.Name,
+			Nickname:      req.Nickname,
+			AccountNumber: number,
+		})
+		if err != nil {
+			log.Error("failed to create beneficiary", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.Status(r, http.StatusCreated)
+		render.JSON(writer, r, resp.OKWithData(map[string]interface{}{
+			"status":      resp.StatusOK,
+			"beneficiary": beneficiary,
+		}))
+	}
+}
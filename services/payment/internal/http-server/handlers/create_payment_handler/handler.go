@@ -0,0 +1,109 @@
+package create_payment_handler
+
+import (
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	"github.com/R1ckNash/Bank/pkg/iban"
+	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"net/http"
+	"payment/internal/http-server/handlers/params"
+	"payment/internal/models"
+	slog_helper "payment/internal/slog"
+)
+
+type PaymentCreator interface {
+	CreatePayment(ctx context.Context, req models.CreatePaymentRequest) (models.Payment, error)
+}
+
+type Request struct {
+	From          string     `json:"from" validate:"required,iban"`
+	To            string     `json:"to" validate:"required_without=BeneficiaryID,omitempty,iban"`
+	BeneficiaryID *uuid.UUID `json:"beneficiary_id"`
+	Amount        string     `json:"amount" validate:"required,numeric"`
+	Description   string     `json:"description" validate:"max=256"`
+}
+
+// New - one-off payment from account of authenticated user; 201 when executed,
+// 202 when it waits for operator review
+func New(log *slog.Logger, creator PaymentCreator) http.HandlerFunc {
+	return func(writer http.ResponseWriter, r *http.Request) {
+		const op = "handlers.payment.create.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		userID, ok := auth.GetUserID(r)
+		if !ok {
+			problem.Write(writer, r, problem.Unauthorized.New(""))
+			return
+		}
+
+		ownerID, err := uuid.Parse(userID)
+		if err != nil {
+			log.Error("Failed to parse user id")
+			problem.Write(writer, r, problem.BadRequest.New("failed to parse user id"))
+			return
+		}
+
+		var req Request
+		if err = validation.DecodeJSON(writer, r, &req); err != nil {
+			log.Error("Failed to decode body", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		if (req.To == "") == (req.BeneficiaryID == nil) {
+			problem.Write(writer, r, problem.BadRequest.New("either to or beneficiary_id is required"))
+			return
+		}
+
+		from, to := iban.Normalize(req.From), iban.Normalize(req.To)
+		if iban.Validate(from) != nil || (req.BeneficiaryID == nil && iban.Validate(to) != nil) {
+			problem.Write(writer, r, problem.BadRequest.New("incorrect account number"))
+			return
+		}
+
+		amount, err := decimal.NewFromString(req.Amount)
+		if err != nil {
+			problem.Write(writer, r, problem.BadRequest.New("invalid amount"))
+			return
+		}
+
+		log.Info("Received request for payment", slog.String("from", from), slog.String("to", to))
+
+		payment, err := creator.CreatePayment(r.Context(), models.CreatePaymentRequest{
+			OwnerID:        ownerID,
+			From:           from,
+			To:             to,
+			BeneficiaryID:  req.BeneficiaryID,
+			Amount:         amount,
+			Description:    req.Description,
+			IdempotencyKey: params.IdempotencyKey(r),
+		})
+		if err != nil {
+			log.Error("failed to create payment", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		status := http.StatusCreated
+		if payment.Status == models.PaymentPendingReview {
+			status = http.StatusAccepted
+		}
+
+		render.Status(r, status)
+		render.JSON(writer, r, resp.OKWithData(map[string]interface{}{
+			"status":  resp.StatusOK,
+			"payment": payment,
+		}))
+	}
+}
@@ -0,0 +1,114 @@
+package create_scheduled_handler
+
+import (
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	"github.com/R1ckNash/Bank/pkg/iban"
+	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"net/http"
+	"payment/internal/models"
+	slog_helper "payment/internal/slog"
+	"time"
+)
+
+type ScheduledPaymentCreator interface {
+	CreateScheduledPayment(ctx context.Context, req models.CreateScheduledPaymentRequest) (models.ScheduledPayment, error)
+}
+
+type Request struct {
+	From          string            `json:"from" validate:"required,iban"`
+	To            string            `json:"to" validate:"required_without=BeneficiaryID,omitempty,iban"`
+	BeneficiaryID *uuid.UUID        `json:"beneficiary_id"`
+	Amount        string            `json:"amount" validate:"required,numeric"`
+	Description   string            `json:"description" validate:"max=256"`
+	Recurrence    models.Recurrence `json:"recurrence" validate:"required"`
+	StartAt       *time.Time        `json:"start_at"`
+	EndAt         *time.Time        `json:"end_at"`
+	MaxRetries    *int              `json:"max_retries" validate:"omitempty,min=0,max=10"`
+}
+
+// New - standing order from account of authenticated user
+func New(log *slog.Logger, creator ScheduledPaymentCreator) http.HandlerFunc {
+	return func(writer http.ResponseWriter, r *http.Request) {
+		const op = "handlers.scheduled.create.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		userID, ok := auth.GetUserID(r)
+		if !ok {
+			problem.Write(writer, r, problem.Unauthorized.New(""))
+			return
+		}
+
+		ownerID, err := uuid.Parse(userID)
+		if err != nil {
+			log.Error("Failed to parse user id")
+			problem.Write(writer, r, problem.BadRequest.New("failed to parse user id"))
+			return
+		}
+
+		var req Request
+		if err = validation.DecodeJSON(writer, r, &req); err != nil {
+			log.Error("Failed to decode body", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		if (req.To == "") == (req.BeneficiaryID == nil) {
+			problem.Write(writer, r, problem.BadRequest.New("either to or beneficiary_id is required"))
+			return
+		}
+
+		from, to := iban.Normalize(req.From), iban.Normalize(req.To)
+		if iban.Validate(from) != nil || (req.BeneficiaryID == nil && iban.Validate(to) != nil) {
+			problem.Write(writer, r, problem.BadRequest.New("incorrect account number"))
+			return
+		}
+
+		amount, err := decimal.NewFromString(req.Amount)
+		if err != nil {
+			problem.Write(writer, r, problem.BadRequest.New("invalid amount"))
+			return
+		}
+
+		create := models.CreateScheduledPaymentRequest{
+			OwnerID:       ownerID,
+			From:          from,
+			To:            to,
+			BeneficiaryID: req.BeneficiaryID,
+			Amount:        amount,
+			Description:   req.Description,
+			Recurrence:    req.Recurrence,
+			EndAt:         req.EndAt,
+			MaxRetries:    req.MaxRetries,
+		}
+		if req.StartAt != nil {
+			create.StartAt = *req.StartAt
+		}
+
+		log.Info("Received request for scheduled payment", slog.String("from", from), slog.String("to", to))
+
+		payment, err := creator.CreateScheduledPayment(r.Context(), create)
+		if err != nil {
+			log.Error("failed to create scheduled payment", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.Status(r, http.StatusCreated)
+		render.JSON(writer, r, resp.OKWithData(map[string]interface{}{
+			"status":            resp.StatusOK,
+			"scheduled_payment": payment,
+		}))
+	}
+}
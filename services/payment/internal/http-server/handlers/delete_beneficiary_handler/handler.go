@@ -0,0 +1,63 @@
+package delete_beneficiary_handler
+
+import (
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+	slog_helper "payment/internal/slog"
+)
+
+type Path struct {
+	BeneficiaryID uuid.UUID `path:"beneficiaryID" validate:"required"`
+}
+
+type BeneficiaryDeleter interface {
+	DeleteBeneficiary(ctx context.Context, id uuid.UUID, ownerID uuid.UUID) error
+}
+
+// New - removes beneficiary of authenticated user
+func New(log *slog.Logger, deleter BeneficiaryDeleter) http.HandlerFunc {
+	return func(writer http.ResponseWriter, r *http.Request) {
+		const op = "handlers.beneficiary.delete.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		userID, ok := auth.GetUserID(r)
+		if !ok {
+			problem.Write(writer, r, problem.Unauthorized.New(""))
+			return
+		}
+
+		ownerID, err := uuid.Parse(userID)
+		if err != nil {
+			log.Error("Failed to parse user id")
+			problem.Write(writer, r, problem.BadRequest.New("failed to parse user id"))
+			return
+		}
+
+		var path Path
+		if err := validation.Path(r, &path); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+		id := path.BeneficiaryID
+
+		if err = deleter.DeleteBeneficiary(r.Context(), id, ownerID); err != nil {
+			log.Error("failed to delete beneficiary", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.JSON(writer, r, resp.OK())
+	}
+}
@@ -0,0 +1,56 @@
+package get_payment_handler
+
+import (
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+	"payment/internal/http-server/handlers/params"
+	"payment/internal/models"
+	slog_helper "payment/internal/slog"
+)
+
+type Path struct {
+	PaymentID uuid.UUID `path:"paymentID" validate:"required"`
+}
+
+type PaymentGetter interface {
+	GetPayment(ctx context.Context, id uuid.UUID) (models.Payment, error)
+}
+
+// New - payment by id, visible to its owner and operators
+func New(log *slog.Logger, getter PaymentGetter) http.HandlerFunc {
+	return func(writer http.ResponseWriter, r *http.Request) {
+		const op = "handlers.payment.get.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		var path Path
+		if err := validation.Path(r, &path); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+		id := path.PaymentID
+
+		payment, err := getter.GetPayment(r.Context(), id)
+		if err != nil {
+			log.Error("failed to retrieve payment", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		if !params.CanAccess(r, payment.OwnerID) {
+			problem.Write(writer, r, problem.NotFound.New("payment not found"))
+			return
+		}
+
+		render.JSON(writer, r, payment)
+	}
+}
@@ -0,0 +1,68 @@
+package list_executions_handler
+
+import (
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+	"payment/internal/http-server/handlers/params"
+	"payment/internal/models"
+	slog_helper "payment/internal/slog"
+)
+
+type Path struct {
+	ScheduleID uuid.UUID `path:"scheduleID" validate:"required"`
+}
+
+type ExecutionLister interface {
+	GetScheduledPayment(ctx context.Context, id uuid.UUID) (models.ScheduledPayment, error)
+	ListExecutions(ctx context.Context, id uuid.UUID) ([]models.Execution, error)
+}
+
+type Response struct {
+	Executions []models.Execution `json:"executions"`
+}
+
+// New - the latest execution attempts of scheduled payment
+func New(log *slog.Logger, lister ExecutionLister) http.HandlerFunc {
+	return func(writer http.ResponseWriter, r *http.Request) {
+		const op = "handlers.scheduled.executions.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		var path Path
+		if err := validation.Path(r, &path); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+		id := path.ScheduleID
+
+		payment, err := lister.GetScheduledPayment(r.Context(), id)
+		if err != nil {
+			log.Error("failed to retrieve scheduled payment", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		if !params.CanAccess(r, payment.OwnerID) {
+			problem.Write(writer, r, problem.NotFound.New("scheduled payment not found"))
+			return
+		}
+
+		executions, err := lister.ListExecutions(r.Context(), id)
+		if err != nil {
+			log.Error("failed to list executions", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.JSON(writer, r, Response{Executions: executions})
+	}
+}
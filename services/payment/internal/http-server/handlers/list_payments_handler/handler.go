@@ -0,0 +1,56 @@
+package list_payments_handler
+
+import (
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+	"payment/internal/models"
+	slog_helper "payment/internal/slog"
+)
+
+type PaymentLister interface {
+	ListPayments(ctx context.Context, ownerID uuid.UUID) ([]models.Payment, error)
+}
+
+type Response struct {
+	Payments []models.Payment `json:"payments"`
+}
+
+// New - the latest payments of authenticated user
+func New(log *slog.Logger, lister PaymentLister) http.HandlerFunc {
+	return func(writer http.ResponseWriter, r *http.Request) {
+		const op = "handlers.payment.list.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		userID, ok := auth.GetUserID(r)
+		if !ok {
+			problem.Write(writer, r, problem.Unauthorized.New(""))
+			return
+		}
+
+		ownerID, err := uuid.Parse(userID)
+		if err != nil {
+			log.Error("Failed to parse user id")
+			problem.Write(writer, r, problem.BadRequest.New("failed to parse user id"))
+			return
+		}
+
+		payments, err := lister.ListPayments(r.Context(), ownerID)
+		if err != nil {
+			log.Error("failed to list payments", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.JSON(writer, r, Response{Payments: payments})
+	}
+}
@@ -0,0 +1,41 @@
+package list_reviews_handler
+
+import (
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"log/slog"
+	"net/http"
+	"payment/internal/models"
+	slog_helper "payment/internal/slog"
+)
+
+type ReviewLister interface {
+	ListReviews(ctx context.Context) ([]models.Payment, error)
+}
+
+type Response struct {
+	Payments []models.Payment `json:"payments"`
+}
+
+// New - operator endpoint listing payments waiting for review, oldest first
+func New(log *slog.Logger, lister ReviewLister) http.HandlerFunc {
+	return func(writer http.ResponseWriter, r *http.Request) {
+		const op = "handlers.review.list.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		payments, err := lister.ListReviews(r.Context())
+		if err != nil {
+			log.Error("failed to list reviews", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.JSON(writer, r, Response{Payments: payments})
+	}
+}
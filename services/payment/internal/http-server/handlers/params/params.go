@@ -0,0 +1,23 @@
+package params
+
+import (
+	"net/http"
+
+	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/google/uuid"
+)
+
+// IdempotencyKeyHeader - repeated request with same key returns result of the first one
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+func IdempotencyKey(r *http.Request) string {
+	return r.Header.Get(IdempotencyKeyHeader)
+}
+
+// CanAccess - payment data is visible to its owner and operators
+func CanAccess(r *http.Request, ownerID uuid.UUID) bool {
+	userID, _ := auth.GetUserID(r)
+	role, _ := auth.GetRole(r)
+
+	return ownerID.String() == userID || role == auth.RoleOperator
+}
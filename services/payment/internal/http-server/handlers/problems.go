@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	"payment/internal/models"
+)
+
+// RegisterProblems - domain errors are written by handlers as these problems (problem.WriteError)
+func RegisterProblems() {
+	problem.Map(models.ErrAccountNotFound, problem.AccountNotFound)
+	problem.Map(models.ErrAccountNotActive, problem.AccountNotActive)
+	problem.Map(models.ErrAlreadyExists, problem.AlreadyExists)
+	problem.Map(models.ErrForbidden, problem.Forbidden)
+	problem.Map(models.ErrInsufficientFunds, problem.InsufficientFunds)
+	problem.Map(models.ErrLimitExceeded, problem.LimitExceeded)
+	problem.Map(models.ErrPaymentDenied, problem.PaymentDenied)
+	problem.Map(models.ErrBeneficiaryCoolingOff, problem.BeneficiaryCoolingOff)
+	problem.Map(models.ErrBeneficiaryRequired, problem.BeneficiaryRequired)
+	problem.Map(models.ErrAccountUnavailable, problem.Unavailable)
+
+	for _, err := range []error{models.ErrNotFound, models.ErrPaymentNotFound, models.ErrBeneficiaryNotFound} {
+		problem.Map(err, problem.NotFound)
+	}
+
+	for _, err := range []error{
+		models.ErrInvalidAmount, models.ErrInvalidRecurrence, models.ErrInvalidSchedule, models.ErrSameAccount,
+		models.ErrInvalidBeneficiary,
+	} {
+		problem.Map(err, problem.BadRequest)
+	}
+
+	for _, err := range []error{models.ErrNotActive, models.ErrNotPendingReview} {
+		problem.Map(err, problem.Conflict)
+	}
+}
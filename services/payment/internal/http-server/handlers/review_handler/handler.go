@@ -0,0 +1,85 @@
+package review_handler
+
+import (
+	"context"
+	"errors"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+	"payment/internal/models"
+	slog_helper "payment/internal/slog"
+)
+
+type Path struct {
+	PaymentID uuid.UUID `path:"paymentID" validate:"required"`
+}
+
+type PaymentReviewer interface {
+	ReviewPayment(ctx context.Context, id uuid.UUID, decision models.ReviewDecision) (models.Payment, error)
+}
+
+type Request struct {
+	Comment string `json:"comment" validate:"max=512"`
+}
+
+// New - operator endpoint applying decision (approve, reject) to payment waiting for review,
+// approved payment is executed at once and its outcome is returned
+func New(log *slog.Logger, reviewer PaymentReviewer, action models.ReviewAction) http.HandlerFunc {
+	return func(writer http.ResponseWriter, r *http.Request) {
+		const op = "handlers.review.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("action", string(action)),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		operatorID, ok := auth.GetUserID(r)
+		if !ok {
+			problem.Write(writer, r, problem.Unauthorized.New(""))
+			return
+		}
+
+		var path Path
+		if err := validation.Path(r, &path); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+		id := path.PaymentID
+
+		var req Request
+		if err := validation.DecodeJSON(writer, r, &req); err != nil {
+			log.Error("Failed to decode body", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		log.Info("Received request for payment review", slog.String("payment_id", id.String()), slog.String("operator_id", operatorID))
+
+		payment, err := reviewer.ReviewPayment(r.Context(), id, models.ReviewDecision{
+			Action:     action,
+			Comment:    req.Comment,
+			ReviewedBy: operatorID,
+		})
+		if err != nil {
+			log.Error("failed to review payment", slog_helper.Err(err))
+			// payment is failed when transfer is rejected, operator sees reason in it
+			if !errors.Is(err, models.ErrInsufficientFunds) && !errors.Is(err, models.ErrLimitExceeded) &&
+				!errors.Is(err, models.ErrAccountNotFound) && !errors.Is(err, models.ErrAccountNotActive) {
+				problem.WriteError(writer, r, err)
+				return
+			}
+		}
+
+		render.JSON(writer, r, resp.OKWithData(map[string]interface{}{
+			"status":  resp.StatusOK,
+			"payment": payment,
+		}))
+	}
+}
@@ -0,0 +1,83 @@
+package update_beneficiary_handler
+
+import (
+	"context"
+	"github.com/R1ckNash/Bank/pkg/api/problem"
+	resp "github.com/R1ckNash/Bank/pkg/api/response"
+	"github.com/R1ckNash/Bank/pkg/middleware/auth"
+	"github.com/R1ckNash/Bank/pkg/validation"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+	"payment/internal/models"
+	slog_helper "payment/internal/slog"
+)
+
+type Path struct {
+	BeneficiaryID uuid.UUID `path:"beneficiaryID" validate:"required"`
+}
+
+type BeneficiaryUpdater interface {
+	UpdateBeneficiary(ctx context.Context, id uuid.UUID, ownerID uuid.UUID, req models.UpdateBeneficiaryRequest) (models.Beneficiary, error)
+}
+
+type Request struct {
+	Name     *string `json:"name" validate:"omitempty,max=128"`
+	Nickname *string `json:"nickname" validate:"omitempty,max=64"`
+}
+
+// New - renames beneficiary of authenticated user, changed name is verified again
+func New(log *slog.Logger, updater BeneficiaryUpdater) http.HandlerFunc {
+	return func(writer http.ResponseWriter, r *http.Request) {
+		const op = "handlers.beneficiary.update.New"
+
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		userID, ok := auth.GetUserID(r)
+		if !ok {
+			problem.Write(writer, r, problem.Unauthorized.New(""))
+			return
+		}
+
+		ownerID, err := uuid.Parse(userID)
+		if err != nil {
+			log.Error("Failed to parse user id")
+			problem.Write(writer, r, problem.BadRequest.New("failed to parse user id"))
+			return
+		}
+
+		var path Path
+		if err := validation.Path(r, &path); err != nil {
+			problem.WriteError(writer, r, err)
+			return
+		}
+		id := path.BeneficiaryID
+
+		var req Request
+		if err := validation.DecodeJSON(writer, r, &req); err != nil {
+			log.Error("Failed to decode body", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		beneficiary, err := updater.UpdateBeneficiary(r.Context(), id, ownerID, models.UpdateBeneficiaryRequest{
+			Name:     req.Name,
+			Nickname: req.Nickname,
+		})
+		if err != nil {
+			log.Error("failed to update beneficiary", slog_helper.Err(err))
+			problem.WriteError(writer, r, err)
+			return
+		}
+
+		render.JSON(writer, r, resp.OKWithData(map[string]interface{}{
+			"status":      resp.StatusOK,
+			"beneficiary": beneficiary,
+		}))
+	}
+}
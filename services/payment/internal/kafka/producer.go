@@ -0,0 +1,39 @@
+package kafka
+
+import (
+	pkgkafka "github.com/R1ckNash/Bank/pkg/kafka"
+	"log/slog"
+	slog_helper "payment/internal/slog"
+)
+
+type Producer struct {
+	producer *pkgkafka.Producer
+	logger   *slog.Logger
+}
+
+func NewProducer(brokers []string, logger *slog.Logger) (*Producer, error) {
+	prod, err := pkgkafka.NewProducer(brokers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Producer{producer: prod, logger: logger}, nil
+}
+
+func (p *Producer) SendMessage(topic, key string, message []byte) error {
+	partition, offset, err := p.producer.Send(topic, key, message)
+	if err != nil {
+		p.logger.Error("failed to send message", slog_helper.Err(err))
+		return err
+	}
+
+	p.logger.Info("message sent",
+		slog.Int("partition", int(partition)),
+		slog.Int64("offset", offset))
+
+	return nil
+}
+
+func (p *Producer) Close() error {
+	return p.producer.Close()
+}
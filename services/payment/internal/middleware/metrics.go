@@ -0,0 +1,24 @@
+package httpdelivery
+
+import (
+	"github.com/go-chi/chi/v5"
+	"net/http"
+	"payment/internal/metrics"
+	"time"
+)
+
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func PrometheusMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := &statusWriter{ResponseWriter: w, status: 200}
+		next.ServeHTTP(ww, r)
+		duration := time.Since(start).Seconds()
+		routePattern := chi.RouteContext(r.Context()).RoutePattern()
+		metrics.HTTPDuration.WithLabelValues(routePattern, r.Method, http.StatusText(ww.status)).Observe(duration)
+	})
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VerificationStatus - how name given by user matches name of beneficiary account
+type VerificationStatus string
+
+const (
+	VerificationMatch      VerificationStatus = "match"
+	VerificationCloseMatch VerificationStatus = "close_match"
+	VerificationNoMatch    VerificationStatus = "no_match"
+)
+
+// CreateBeneficiaryRequest - payee saved by OwnerID, Name is expected name of account holder
+type CreateBeneficiaryRequest struct {
+	OwnerID       uuid.UUID
+	Name          string
+	Nickname      string
+	AccountNumber string
+}
+
+// UpdateBeneficiaryRequest - nil fields are kept, changed name is verified again
+type UpdateBeneficiaryRequest struct {
+	Name     *string
+	Nickname *string
+}
+
+// Verification - result of name check, MatchedName is revealed only for close match
+type Verification struct {
+	Status      VerificationStatus `json:"status"`
+	MatchedName string             `json:"matched_name,omitempty"`
+	VerifiedAt  time.Time          `json:"verified_at"`
+}
+
+// Beneficiary - saved payee, large payments to it are not allowed before CoolingOffUntil
+type Beneficiary struct {
+	ID              uuid.UUID    `json:"id"`
+	OwnerID         uuid.UUID    `json:"owner_id"`
+	Name            string       `json:"name"`
+	Nickname        string       `json:"nickname,omitempty"`
+	AccountNumber   string       `json:"account_number"`
+	Currency        string       `json:"currency"`
+	Verification    Verification `json:"verification"`
+	CoolingOffUntil time.Time    `json:"cooling_off_until"`
+	CreatedAt       time.Time    `json:"created_at"`
+	UpdatedAt       time.Time    `json:"updated_at"`
+}
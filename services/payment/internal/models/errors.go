@@ -0,0 +1,26 @@
+package models
+
+import "errors"
+
+var (
+	ErrNotFound              = errors.New("scheduled payment not found")
+	ErrPaymentNotFound       = errors.New("payment not found")
+	ErrNotPendingReview      = errors.New("payment is not pending review")
+	ErrPaymentDenied         = errors.New("payment denied by risk checks")
+	ErrBeneficiaryNotFound   = errors.New("beneficiary not found")
+	ErrBeneficiaryCoolingOff = errors.New("beneficiary is in cooling-off period")
+	ErrBeneficiaryRequired   = errors.New("amount can only be sent to saved beneficiary")
+	ErrInvalidBeneficiary    = errors.New("invalid beneficiary")
+	ErrForbidden             = errors.New("forbidden")
+	ErrAlreadyExists         = errors.New("already exists")
+	ErrInvalidAmount         = errors.New("invalid amount")
+	ErrInvalidRecurrence     = errors.New("invalid recurrence")
+	ErrInvalidSchedule       = errors.New("invalid schedule period")
+	ErrSameAccount           = errors.New("source and destination accounts are the same")
+	ErrAccountNotFound       = errors.New("account not found")
+	ErrAccountNotActive      = errors.New("account is not active")
+	ErrInsufficientFunds     = errors.New("insufficient funds")
+	ErrLimitExceeded         = errors.New("payment limit exceeded")
+	ErrNotActive             = errors.New("scheduled payment is not active")
+	ErrAccountUnavailable    = errors.New("account service unavailable")
+)
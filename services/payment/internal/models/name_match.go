@@ -0,0 +1,93 @@
+package models
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// maxNameDistance - edit distance of normalized names still considered close match (typos)
+const maxNameDistance = 2
+
+// MatchName - compares name given by user with name of account: equal names or the same words in other order
+// are match, typos, missing middle names and initials are close match
+func MatchName(given, actual string) VerificationStatus {
+	g, a := nameTokens(given), nameTokens(actual)
+	if len(g) == 0 || len(a) == 0 {
+		return VerificationNoMatch
+	}
+
+	gs, as := sortedCopy(g), sortedCopy(a)
+	if strings.Join(gs, " ") == strings.Join(as, " ") {
+		return VerificationMatch
+	}
+
+	if levenshtein(strings.Join(gs, " "), strings.Join(as, " ")) <= maxNameDistance ||
+		tokensCovered(g, a) || tokensCovered(a, g) {
+		return VerificationCloseMatch
+	}
+
+	return VerificationNoMatch
+}
+
+// nameTokens - lower-cased words of name without punctuation
+func nameTokens(name string) []string {
+	return strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// tokensCovered - every word of short is a word or initial of word of long, and surnames (last words) are equal
+func tokensCovered(short, long []string) bool {
+	if len(short) > len(long) || short[len(short)-1] != long[len(long)-1] {
+		return false
+	}
+
+	used := make([]bool, len(long))
+	for _, s := range short {
+		found := false
+		for i, l := range long {
+			if used[i] {
+				continue
+			}
+			if s == l || (len(s) == 1 && strings.HasPrefix(l, s)) {
+				used[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortedCopy(tokens []string) []string {
+	result := append([]string(nil), tokens...)
+	sort.Strings(result)
+	return result
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(rb)]
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentStatus - processing payment is being executed, pending_review one waits for operator decision
+type PaymentStatus string
+
+const (
+	PaymentProcessing    PaymentStatus = "processing"
+	PaymentPendingReview PaymentStatus = "pending_review"
+	PaymentCompleted     PaymentStatus = "completed"
+	PaymentFailed        PaymentStatus = "failed"
+	PaymentDenied        PaymentStatus = "denied"
+	PaymentRejected      PaymentStatus = "rejected"
+)
+
+// ReviewAction - operator decision on payment in review queue
+type ReviewAction string
+
+const (
+	ReviewApprove ReviewAction = "approve"
+	ReviewReject  ReviewAction = "reject"
+)
+
+// CreatePaymentRequest - one-off payment of OwnerID from account From to account To
+// or to saved beneficiary BeneficiaryID
+type CreatePaymentRequest struct {
+	OwnerID        uuid.UUID
+	From           string
+	To             string
+	BeneficiaryID  *uuid.UUID
+	Amount         decimal.Decimal
+	Description    string
+	IdempotencyKey string
+}
+
+// ReviewDecision - operator decision with comment kept for audit
+type ReviewDecision struct {
+	Action     ReviewAction
+	Comment    string
+	ReviewedBy string
+}
+
+// Payment - one-off payment with result of risk checks
+type Payment struct {
+	ID            uuid.UUID      `json:"id"`
+	OwnerID       uuid.UUID      `json:"owner_id"`
+	From          string         `json:"from"`
+	To            string         `json:"to"`
+	Amount        string         `json:"amount"`
+	Currency      string         `json:"currency"`
+	Description   string         `json:"description,omitempty"`
+	Status        PaymentStatus  `json:"status"`
+	Risk          RiskAssessment `json:"risk"`
+	TransactionID *uuid.UUID     `json:"transaction_id,omitempty"`
+	Error         string         `json:"error,omitempty"`
+	ReviewedBy    *string        `json:"reviewed_by,omitempty"`
+	ReviewComment string         `json:"review_comment,omitempty"`
+	ReviewedAt    *time.Time     `json:"reviewed_at,omitempty"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
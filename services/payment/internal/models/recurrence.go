@@ -0,0 +1,107 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// RecurrenceType - how occurrences of scheduled payment repeat
+type RecurrenceType string
+
+const (
+	RecurrenceOnce    RecurrenceType = "once"
+	RecurrenceWeekly  RecurrenceType = "weekly"
+	RecurrenceMonthly RecurrenceType = "monthly"
+	RecurrenceCron    RecurrenceType = "cron"
+)
+
+// Recurrence - weekly payments repeat every Interval weeks on weekday and time of start,
+// monthly ones every Interval months on DayOfMonth (last day of shorter months) at time of start,
+// Cron is standard 5-field expression evaluated in UTC
+type Recurrence struct {
+	Type       RecurrenceType `json:"type"`
+	Interval   int            `json:"interval,omitempty"`
+	DayOfMonth int            `json:"day_of_month,omitempty"`
+	Cron       string         `json:"cron,omitempty"`
+}
+
+// Validate - checks fields required by recurrence type
+func (r Recurrence) Validate() error {
+	switch r.Type {
+	case RecurrenceOnce:
+	case RecurrenceWeekly, RecurrenceMonthly:
+		if r.Interval < 1 {
+			return fmt.Errorf("%w: interval must be positive", ErrInvalidRecurrence)
+		}
+		if r.Type == RecurrenceMonthly && (r.DayOfMonth < 1 || r.DayOfMonth > 31) {
+			return fmt.Errorf("%w: day_of_month must be between 1 and 31", ErrInvalidRecurrence)
+		}
+	case RecurrenceCron:
+		if _, err := cron.ParseStandard(r.Cron); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidRecurrence, err)
+		}
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidRecurrence, r.Type)
+	}
+
+	return nil
+}
+
+// Next - first occurrence strictly after `after` and not before start, false if there is none
+func (r Recurrence) Next(start, after time.Time) (time.Time, bool) {
+	start, after = start.UTC(), after.UTC()
+
+	switch r.Type {
+	case RecurrenceOnce:
+		return start, start.After(after)
+	case RecurrenceWeekly:
+		period := time.Duration(r.Interval) * 7 * 24 * time.Hour
+		k := 0
+		if after.After(start) {
+			k = int(after.Sub(start) / period)
+		}
+		for {
+			t := start.AddDate(0, 0, 7*r.Interval*k)
+			if t.After(after) {
+				return t, true
+			}
+			k++
+		}
+	case RecurrenceMonthly:
+		k := 0
+		if after.After(start) {
+			months := (after.Year()-start.Year())*12 + int(after.Month()-start.Month())
+			k = max(months/r.Interval-1, 0)
+		}
+		for {
+			t := r.monthlyOccurrence(start, k)
+			if t.After(after) && !t.Before(start) {
+				return t, true
+			}
+			k++
+		}
+	case RecurrenceCron:
+		schedule, err := cron.ParseStandard(r.Cron)
+		if err != nil {
+			return time.Time{}, false
+		}
+		if after.Before(start) {
+			after = start.Add(-time.Nanosecond)
+		}
+		t := schedule.Next(after)
+		return t, !t.IsZero()
+	}
+
+	return time.Time{}, false
+}
+
+// monthlyOccurrence - k-th month of schedule, day is clamped to length of month
+func (r Recurrence) monthlyOccurrence(start time.Time, k int) time.Time {
+	month := time.Date(start.Year(), start.Month()+time.Month(k*r.Interval), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := month.AddDate(0, 1, -1).Day()
+
+	return time.Date(month.Year(), month.Month(), min(r.DayOfMonth, lastDay),
+		start.Hour(), start.Minute(), start.Second(), 0, time.UTC)
+}
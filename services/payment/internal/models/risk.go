@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// RiskDecision - outcome of pre-authorization checks, deny is the most severe
+type RiskDecision string
+
+const (
+	RiskAllow  RiskDecision = "allow"
+	RiskReview RiskDecision = "review"
+	RiskDeny   RiskDecision = "deny"
+)
+
+// IsValid - known decision
+func (d RiskDecision) IsValid() bool {
+	switch d {
+	case RiskAllow, RiskReview, RiskDeny:
+		return true
+	}
+	return false
+}
+
+// Severity - deny > review > allow
+func (d RiskDecision) Severity() int {
+	switch d {
+	case RiskDeny:
+		return 2
+	case RiskReview:
+		return 1
+	}
+	return 0
+}
+
+// RiskInput - transfer being checked, At is time of request
+type RiskInput struct {
+	OwnerID  uuid.UUID
+	From     string
+	To       string
+	Amount   decimal.Decimal
+	Currency string
+	At       time.Time
+}
+
+// RiskAssessment - decision of the most severe matched rule, Reasons are names of all matched rules
+type RiskAssessment struct {
+	Decision RiskDecision `json:"decision"`
+	Reasons  []string     `json:"reasons,omitempty"`
+}
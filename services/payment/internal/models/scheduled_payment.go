@@ -0,0 +1,79 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ScheduleStatus - payment is active until it's cancelled or has no more occurrences
+type ScheduleStatus string
+
+const (
+	ScheduleActive    ScheduleStatus = "active"
+	ScheduleCancelled ScheduleStatus = "cancelled"
+	ScheduleCompleted ScheduleStatus = "completed"
+)
+
+// ExecutionStatus - outcome of single attempt to execute occurrence
+type ExecutionStatus string
+
+const (
+	ExecutionSucceeded         ExecutionStatus = "succeeded"
+	ExecutionInsufficientFunds ExecutionStatus = "insufficient_funds"
+	ExecutionFailed            ExecutionStatus = "failed"
+	// ExecutionDenied - occurrence is denied by risk checks and skipped
+	ExecutionDenied ExecutionStatus = "denied"
+	// ExecutionPendingReview - occurrence is sent to review queue as payment PaymentID
+	ExecutionPendingReview ExecutionStatus = "pending_review"
+)
+
+// CreateScheduledPaymentRequest - standing order of OwnerID paid from account From
+// to account To or to saved beneficiary BeneficiaryID
+type CreateScheduledPaymentRequest struct {
+	OwnerID       uuid.UUID
+	From          string
+	To            string
+	BeneficiaryID *uuid.UUID
+	Amount        decimal.Decimal
+	Description   string
+	Recurrence    Recurrence
+	StartAt       time.Time
+	EndAt         *time.Time
+	MaxRetries    *int
+}
+
+// ScheduledPayment - standing order; ScheduledFor is occurrence being executed, NextRunAt is moment
+// of next attempt, they differ while insufficient funds are retried
+type ScheduledPayment struct {
+	ID           uuid.UUID      `json:"id"`
+	OwnerID      uuid.UUID      `json:"owner_id"`
+	From         string         `json:"from"`
+	To           string         `json:"to"`
+	Amount       string         `json:"amount"`
+	Currency     string         `json:"currency"`
+	Description  string         `json:"description,omitempty"`
+	Recurrence   Recurrence     `json:"recurrence"`
+	StartAt      time.Time      `json:"start_at"`
+	EndAt        *time.Time     `json:"end_at,omitempty"`
+	ScheduledFor *time.Time     `json:"scheduled_for,omitempty"`
+	NextRunAt    *time.Time     `json:"next_run_at,omitempty"`
+	Attempt      int            `json:"attempt"`
+	MaxRetries   int            `json:"max_retries"`
+	Status       ScheduleStatus `json:"status"`
+	LastRunAt    *time.Time     `json:"last_run_at,omitempty"`
+	CreatedAt    time.Time      `json:"created_at"`
+}
+
+// Execution - attempt to execute occurrence of scheduled payment
+type Execution struct {
+	ID            uuid.UUID       `json:"id"`
+	ScheduledFor  time.Time       `json:"scheduled_for"`
+	Attempt       int             `json:"attempt"`
+	Status        ExecutionStatus `json:"status"`
+	TransactionID *uuid.UUID      `json:"transaction_id,omitempty"`
+	PaymentID     *uuid.UUID      `json:"payment_id,omitempty"`
+	Error         string          `json:"error,omitempty"`
+	ExecutedAt    time.Time       `json:"executed_at"`
+}
@@ -0,0 +1,144 @@
+package payment_storage
+
+import (
+	"context"
+	"errors"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"payment/internal/models"
+)
+
+const selectBeneficiary = `SELECT id, owner_id, name, nickname, account_number, currency, verification_status, verified_name,
+			  verified_at, cooling_off_until, created_at, updated_at
+			  FROM beneficiaries`
+
+func (s *PaymentStorage) CreateBeneficiary(ctx context.Context, b *Beneficiary) error {
+	const api = "payment_storage.CreateBeneficiary"
+
+	query := `INSERT INTO beneficiaries (id, owner_id, name, nickname, account_number, currency, verification_status, verified_name,
+			  verified_at, cooling_off_until, created_at, updated_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+
+	_, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, b.ID, b.OwnerID, b.Name, b.Nickname, b.AccountNumber, b.Currency,
+		b.VerificationStatus, b.VerifiedName, b.VerifiedAt, b.CoolingOffUntil, b.CreatedAt, b.UpdatedAt)
+	if err != nil {
+		var pgError *pgconn.PgError
+		if errors.As(err, &pgError) && pgError.Code == pgerrcode.UniqueViolation {
+			return pkgerrors.Wrap(api, models.ErrAlreadyExists)
+		}
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+// UpdateBeneficiary - saves names and result of verification, account number and cooling-off are immutable
+func (s *PaymentStorage) UpdateBeneficiary(ctx context.Context, b *Beneficiary) error {
+	const api = "payment_storage.UpdateBeneficiary"
+
+	query := `UPDATE beneficiaries SET name=$1, nickname=$2, verification_status=$3, verified_name=$4, verified_at=$5, updated_at=$6
+			  WHERE id=$7`
+
+	_, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, b.Name, b.Nickname, b.VerificationStatus, b.VerifiedName, b.VerifiedAt, b.UpdatedAt, b.ID)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+func (s *PaymentStorage) DeleteBeneficiary(ctx context.Context, id uuid.UUID) error {
+	const api = "payment_storage.DeleteBeneficiary"
+
+	tag, err := s.driver.GetQueryEngine(ctx).Exec(ctx, `DELETE FROM beneficiaries WHERE id=$1`, id)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return pkgerrors.Wrap(api, models.ErrBeneficiaryNotFound)
+	}
+
+	return nil
+}
+
+func (s *PaymentStorage) GetBeneficiary(ctx context.Context, id uuid.UUID) (Beneficiary, error) {
+	const api = "payment_storage.GetBeneficiary"
+
+	b, err := s.getBeneficiary(ctx, selectBeneficiary+` WHERE id=$1`, id)
+	if err != nil {
+		return Beneficiary{}, pkgerrors.Wrap(api, err)
+	}
+
+	return b, nil
+}
+
+// GetBeneficiaryByAccount - beneficiary of owner with account number
+func (s *PaymentStorage) GetBeneficiaryByAccount(ctx context.Context, ownerID uuid.UUID, accountNumber string) (Beneficiary, error) {
+	const api = "payment_storage.GetBeneficiaryByAccount"
+
+	b, err := s.getBeneficiary(ctx, selectBeneficiary+` WHERE owner_id=$1 AND account_number=$2`, ownerID, accountNumber)
+	if err != nil {
+		return Beneficiary{}, pkgerrors.Wrap(api, err)
+	}
+
+	return b, nil
+}
+
+// ListBeneficiaries - beneficiaries of owner ordered by name
+func (s *PaymentStorage) ListBeneficiaries(ctx context.Context, ownerID uuid.UUID) ([]Beneficiary, error) {
+	const api = "payment_storage.ListBeneficiaries"
+
+	rows, err := s.driver.GetQueryEngine(ctx).Query(ctx, selectBeneficiary+` WHERE owner_id=$1 ORDER BY name, created_at`, ownerID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+	defer rows.Close()
+
+	var result []Beneficiary
+	for rows.Next() {
+		b, err := scanBeneficiary(rows)
+		if err != nil {
+			return nil, pkgerrors.Wrap(api, err)
+		}
+		result = append(result, b)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return result, nil
+}
+
+func (s *PaymentStorage) getBeneficiary(ctx context.Context, query string, args ...interface{}) (Beneficiary, error) {
+	b, err := scanBeneficiary(s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Beneficiary{}, models.ErrBeneficiaryNotFound
+		}
+		return Beneficiary{}, err
+	}
+
+	return b, nil
+}
+
+func scanBeneficiary(row pgx.Row) (Beneficiary, error) {
+	var b Beneficiary
+	err := row.Scan(
+		&b.ID,
+		&b.OwnerID,
+		&b.Name,
+		&b.Nickname,
+		&b.AccountNumber,
+		&b.Currency,
+		&b.VerificationStatus,
+		&b.VerifiedName,
+		&b.VerifiedAt,
+		&b.CoolingOffUntil,
+		&b.CreatedAt,
+		&b.UpdatedAt,
+	)
+	return b, err
+}
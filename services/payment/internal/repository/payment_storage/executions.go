@@ -0,0 +1,60 @@
+package payment_storage
+
+import (
+	"context"
+	"errors"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"payment/internal/models"
+)
+
+func (s *PaymentStorage) CreateExecution(ctx context.Context, e *Execution) error {
+	const api = "payment_storage.CreateExecution"
+
+	query := `INSERT INTO scheduled_payment_executions (id, schedule_id, scheduled_for, attempt, status, transaction_id, payment_id, error, executed_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, e.ID, e.ScheduleID, e.ScheduledFor, e.Attempt, e.Status, e.TransactionID, e.PaymentID, e.Error, e.ExecutedAt)
+	if err != nil {
+		var pgError *pgconn.PgError
+		if errors.As(err, &pgError) && pgError.Code == pgerrcode.UniqueViolation {
+			return pkgerrors.Wrap(api, models.ErrAlreadyExists)
+		}
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+// ListExecutions - attempts of schedule, newest first
+func (s *PaymentStorage) ListExecutions(ctx context.Context, scheduleID uuid.UUID, limit int) ([]Execution, error) {
+	const api = "payment_storage.ListExecutions"
+
+	query := `SELECT id, schedule_id, scheduled_for, attempt, status, transaction_id, payment_id, error, executed_at
+			  FROM scheduled_payment_executions
+			  WHERE schedule_id=$1
+			  ORDER BY executed_at DESC
+			  LIMIT $2`
+
+	rows, err := s.driver.GetQueryEngine(ctx).Query(ctx, query, scheduleID, limit)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+	defer rows.Close()
+
+	var result []Execution
+	for rows.Next() {
+		var e Execution
+		if err = rows.Scan(&e.ID, &e.ScheduleID, &e.ScheduledFor, &e.Attempt, &e.Status, &e.TransactionID, &e.PaymentID, &e.Error, &e.ExecutedAt); err != nil {
+			return nil, pkgerrors.Wrap(api, err)
+		}
+		result = append(result, e)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return result, nil
+}
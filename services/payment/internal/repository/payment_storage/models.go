@@ -0,0 +1,79 @@
+package payment_storage
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+type ScheduledPayment struct {
+	ID                 uuid.UUID       `db:"id"`
+	OwnerID            uuid.UUID       `db:"owner_id"`
+	FromAccount        string          `db:"from_account"`
+	ToAccount          string          `db:"to_account"`
+	Amount             decimal.Decimal `db:"amount"`
+	Currency           string          `db:"currency"`
+	Description        string          `db:"description"`
+	RecurrenceType     string          `db:"recurrence_type"`
+	RecurrenceInterval int             `db:"recurrence_interval"`
+	DayOfMonth         *int            `db:"day_of_month"`
+	CronExpr           string          `db:"cron_expr"`
+	StartAt            time.Time       `db:"start_at"`
+	EndAt              *time.Time      `db:"end_at"`
+	ScheduledFor       *time.Time      `db:"scheduled_for"`
+	NextRunAt          *time.Time      `db:"next_run_at"`
+	Attempt            int             `db:"attempt"`
+	MaxRetries         int             `db:"max_retries"`
+	Status             string          `db:"status"`
+	LastRunAt          *time.Time      `db:"last_run_at"`
+	CreatedAt          time.Time       `db:"created_at"`
+	UpdatedAt          time.Time       `db:"updated_at"`
+}
+
+type Execution struct {
+	ID            uuid.UUID  `db:"id"`
+	ScheduleID    uuid.UUID  `db:"schedule_id"`
+	ScheduledFor  time.Time  `db:"scheduled_for"`
+	Attempt       int        `db:"attempt"`
+	Status        string     `db:"status"`
+	TransactionID *uuid.UUID `db:"transaction_id"`
+	PaymentID     *uuid.UUID `db:"payment_id"`
+	Error         string     `db:"error"`
+	ExecutedAt    time.Time  `db:"executed_at"`
+}
+
+type Payment struct {
+	ID             uuid.UUID       `db:"id"`
+	OwnerID        uuid.UUID       `db:"owner_id"`
+	FromAccount    string          `db:"from_account"`
+	ToAccount      string          `db:"to_account"`
+	Amount         decimal.Decimal `db:"amount"`
+	Currency       string          `db:"currency"`
+	Description    string          `db:"description"`
+	Status         string          `db:"status"`
+	RiskDecision   string          `db:"risk_decision"`
+	RiskReasons    []string        `db:"risk_reasons"`
+	TransactionID  *uuid.UUID      `db:"transaction_id"`
+	Error          string          `db:"error"`
+	IdempotencyKey *string         `db:"idempotency_key"`
+	ReviewedBy     *string         `db:"reviewed_by"`
+	ReviewComment  string          `db:"review_comment"`
+	ReviewedAt     *time.Time      `db:"reviewed_at"`
+	CreatedAt      time.Time       `db:"created_at"`
+	UpdatedAt      time.Time       `db:"updated_at"`
+}
+
+type Beneficiary struct {
+	ID                 uuid.UUID `db:"id"`
+	OwnerID            uuid.UUID `db:"owner_id"`
+	Name               string    `db:"name"`
+	Nickname           string    `db:"nickname"`
+	AccountNumber      string    `db:"account_number"`
+	Currency           string    `db:"currency"`
+	VerificationStatus string    `db:"verification_status"`
+	VerifiedName       string    `db:"verified_name"`
+	VerifiedAt         time.Time `db:"verified_at"`
+	CoolingOffUntil    time.Time `db:"cooling_off_until"`
+	CreatedAt          time.Time `db:"created_at"`
+	UpdatedAt          time.Time `db:"updated_at"`
+}
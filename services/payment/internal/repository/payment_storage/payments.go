@@ -0,0 +1,195 @@
+package payment_storage
+
+import (
+	"context"
+	"errors"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"payment/internal/models"
+	"time"
+)
+
+const selectPayment = `SELECT id, owner_id, from_account, to_account, amount, currency, description, status,
+			  risk_decision, risk_reasons, transaction_id, error, idempotency_key, reviewed_by, review_comment, reviewed_at,
+			  created_at, updated_at
+			  FROM payments`
+
+func (s *PaymentStorage) CreatePayment(ctx context.Context, p *Payment) error {
+	const api = "payment_storage.CreatePayment"
+
+	query := `INSERT INTO payments (id, owner_id, from_account, to_account, amount, currency, description, status,
+			  risk_decision, risk_reasons, transaction_id, error, idempotency_key, created_at, updated_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`
+
+	_, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, p.ID, p.OwnerID, p.FromAccount, p.ToAccount, p.Amount, p.Currency, p.Description, p.Status,
+		p.RiskDecision, p.RiskReasons, p.TransactionID, p.Error, p.IdempotencyKey, p.CreatedAt, p.UpdatedAt)
+	if err != nil {
+		var pgError *pgconn.PgError
+		if errors.As(err, &pgError) && pgError.Code == pgerrcode.UniqueViolation {
+			return pkgerrors.Wrap(api, models.ErrAlreadyExists)
+		}
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+// UpdatePayment - saves status, result of execution and review
+func (s *PaymentStorage) UpdatePayment(ctx context.Context, p *Payment) error {
+	const api = "payment_storage.UpdatePayment"
+
+	query := `UPDATE payments SET status=$1, transaction_id=$2, error=$3, reviewed_by=$4, review_comment=$5, reviewed_at=$6, updated_at=$7
+			  WHERE id=$8`
+
+	_, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, p.Status, p.TransactionID, p.Error, p.ReviewedBy, p.ReviewComment, p.ReviewedAt, p.UpdatedAt, p.ID)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+func (s *PaymentStorage) GetPayment(ctx context.Context, id uuid.UUID) (Payment, error) {
+	const api = "payment_storage.GetPayment"
+
+	p, err := s.getPayment(ctx, selectPayment+` WHERE id=$1`, id)
+	if err != nil {
+		return Payment{}, pkgerrors.Wrap(api, err)
+	}
+
+	return p, nil
+}
+
+// GetPaymentForUpdate - locks payment row until the end of transaction
+func (s *PaymentStorage) GetPaymentForUpdate(ctx context.Context, id uuid.UUID) (Payment, error) {
+	const api = "payment_storage.GetPaymentForUpdate"
+
+	p, err := s.getPayment(ctx, selectPayment+` WHERE id=$1 FOR UPDATE`, id)
+	if err != nil {
+		return Payment{}, pkgerrors.Wrap(api, err)
+	}
+
+	return p, nil
+}
+
+func (s *PaymentStorage) GetPaymentByIdempotencyKey(ctx context.Context, ownerID uuid.UUID, key string) (Payment, error) {
+	const api = "payment_storage.GetPaymentByIdempotencyKey"
+
+	p, err := s.getPayment(ctx, selectPayment+` WHERE owner_id=$1 AND idempotency_key=$2`, ownerID, key)
+	if err != nil {
+		return Payment{}, pkgerrors.Wrap(api, err)
+	}
+
+	return p, nil
+}
+
+// ListPayments - payments of owner, newest first
+func (s *PaymentStorage) ListPayments(ctx context.Context, ownerID uuid.UUID, limit int) ([]Payment, error) {
+	const api = "payment_storage.ListPayments"
+
+	payments, err := s.listPayments(ctx, selectPayment+` WHERE owner_id=$1 ORDER BY created_at DESC LIMIT $2`, ownerID, limit)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return payments, nil
+}
+
+// ListPaymentsByStatus - payments of all owners in status, oldest first
+func (s *PaymentStorage) ListPaymentsByStatus(ctx context.Context, status string, limit int) ([]Payment, error) {
+	const api = "payment_storage.ListPaymentsByStatus"
+
+	payments, err := s.listPayments(ctx, selectPayment+` WHERE status=$1 ORDER BY created_at LIMIT $2`, status, limit)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return payments, nil
+}
+
+// HasPaidTo - owner has completed payment to account
+func (s *PaymentStorage) HasPaidTo(ctx context.Context, ownerID uuid.UUID, to string) (bool, error) {
+	const api = "payment_storage.HasPaidTo"
+
+	query := `SELECT EXISTS (SELECT 1 FROM payments WHERE owner_id=$1 AND to_account=$2 AND status='completed')`
+
+	var exists bool
+	if err := s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, ownerID, to).Scan(&exists); err != nil {
+		return false, pkgerrors.Wrap(api, err)
+	}
+
+	return exists, nil
+}
+
+// CountPayments - payments of owner created since, denied ones included
+func (s *PaymentStorage) CountPayments(ctx context.Context, ownerID uuid.UUID, since time.Time) (int, error) {
+	const api = "payment_storage.CountPayments"
+
+	query := `SELECT COUNT(*) FROM payments WHERE owner_id=$1 AND created_at>=$2`
+
+	var count int
+	if err := s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, ownerID, since).Scan(&count); err != nil {
+		return 0, pkgerrors.Wrap(api, err)
+	}
+
+	return count, nil
+}
+
+func (s *PaymentStorage) getPayment(ctx context.Context, query string, args ...interface{}) (Payment, error) {
+	p, err := scanPayment(s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Payment{}, models.ErrPaymentNotFound
+		}
+		return Payment{}, err
+	}
+
+	return p, nil
+}
+
+func (s *PaymentStorage) listPayments(ctx context.Context, query string, args ...interface{}) ([]Payment, error) {
+	rows, err := s.driver.GetQueryEngine(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []Payment
+	for rows.Next() {
+		p, err := scanPayment(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, p)
+	}
+
+	return result, rows.Err()
+}
+
+func scanPayment(row pgx.Row) (Payment, error) {
+	var p Payment
+	err := row.Scan(
+		&p.ID,
+		&p.OwnerID,
+		&p.FromAccount,
+		&p.ToAccount,
+		&p.Amount,
+		&p.Currency,
+		&p.Description,
+		&p.Status,
+		&p.RiskDecision,
+		&p.RiskReasons,
+		&p.TransactionID,
+		&p.Error,
+		&p.IdempotencyKey,
+		&p.ReviewedBy,
+		&p.ReviewComment,
+		&p.ReviewedAt,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	)
+	return p, err
+}
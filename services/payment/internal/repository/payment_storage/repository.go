@@ -0,0 +1,18 @@
+package payment_storage
+
+import (
+	"context"
+	"github.com/R1ckNash/Bank/pkg/transaction_manager"
+)
+
+type QueryEngineProvider interface {
+	GetQueryEngine(ctx context.Context) transaction_manager.QueryEngine
+}
+
+type PaymentStorage struct {
+	driver QueryEngineProvider
+}
+
+func New(driver QueryEngineProvider) *PaymentStorage {
+	return &PaymentStorage{driver: driver}
+}
@@ -0,0 +1,152 @@
+package payment_storage
+
+import (
+	"context"
+	"errors"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"payment/internal/models"
+	"time"
+)
+
+const selectScheduledPayment = `SELECT id, owner_id, from_account, to_account, amount, currency, description,
+			  recurrence_type, recurrence_interval, day_of_month, cron_expr, start_at, end_at, scheduled_for, next_run_at,
+			  attempt, max_retries, status, last_run_at, created_at, updated_at
+			  FROM scheduled_payments`
+
+func (s *PaymentStorage) CreateScheduledPayment(ctx context.Context, p *ScheduledPayment) error {
+	const api = "payment_storage.CreateScheduledPayment"
+
+	query := `INSERT INTO scheduled_payments (id, owner_id, from_account, to_account, amount, currency, description,
+			  recurrence_type, recurrence_interval, day_of_month, cron_expr, start_at, end_at, scheduled_for, next_run_at,
+			  attempt, max_retries, status, created_at, updated_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)`
+
+	_, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, p.ID, p.OwnerID, p.FromAccount, p.ToAccount, p.Amount, p.Currency, p.Description,
+		p.RecurrenceType, p.RecurrenceInterval, p.DayOfMonth, p.CronExpr, p.StartAt, p.EndAt, p.ScheduledFor, p.NextRunAt,
+		p.Attempt, p.MaxRetries, p.Status, p.CreatedAt, p.UpdatedAt)
+	if err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+// UpdateSchedule - saves progress of schedule: occurrence, next attempt, status
+func (s *PaymentStorage) UpdateSchedule(ctx context.Context, p *ScheduledPayment) error {
+	const api = "payment_storage.UpdateSchedule"
+
+	query := `UPDATE scheduled_payments SET scheduled_for=$1, next_run_at=$2, attempt=$3, status=$4, last_run_at=$5, updated_at=$6 WHERE id=$7`
+
+	if _, err := s.driver.GetQueryEngine(ctx).Exec(ctx, query, p.ScheduledFor, p.NextRunAt, p.Attempt, p.Status, p.LastRunAt, p.UpdatedAt, p.ID); err != nil {
+		return pkgerrors.Wrap(api, err)
+	}
+
+	return nil
+}
+
+func (s *PaymentStorage) GetScheduledPayment(ctx context.Context, id uuid.UUID) (ScheduledPayment, error) {
+	const api = "payment_storage.GetScheduledPayment"
+
+	p, err := s.getScheduledPayment(ctx, selectScheduledPayment+` WHERE id=$1`, id)
+	if err != nil {
+		return ScheduledPayment{}, pkgerrors.Wrap(api, err)
+	}
+
+	return p, nil
+}
+
+// GetScheduledPaymentForUpdate - locks schedule row until the end of transaction
+func (s *PaymentStorage) GetScheduledPaymentForUpdate(ctx context.Context, id uuid.UUID) (ScheduledPayment, error) {
+	const api = "payment_storage.GetScheduledPaymentForUpdate"
+
+	p, err := s.getScheduledPayment(ctx, selectScheduledPayment+` WHERE id=$1 FOR UPDATE`, id)
+	if err != nil {
+		return ScheduledPayment{}, pkgerrors.Wrap(api, err)
+	}
+
+	return p, nil
+}
+
+// ClaimDuePayment - locks one active payment due at `at`, rows locked by other instances are skipped,
+// returns models.ErrNotFound when nothing is due
+func (s *PaymentStorage) ClaimDuePayment(ctx context.Context, at time.Time) (ScheduledPayment, error) {
+	const api = "payment_storage.ClaimDuePayment"
+
+	query := selectScheduledPayment + ` WHERE status='active' AND next_run_at <= $1
+			  ORDER BY next_run_at
+			  LIMIT 1
+			  FOR UPDATE SKIP LOCKED`
+
+	p, err := s.getScheduledPayment(ctx, query, at)
+	if err != nil {
+		return ScheduledPayment{}, pkgerrors.Wrap(api, err)
+	}
+
+	return p, nil
+}
+
+func (s *PaymentStorage) ListScheduledPayments(ctx context.Context, ownerID uuid.UUID) ([]ScheduledPayment, error) {
+	const api = "payment_storage.ListScheduledPayments"
+
+	rows, err := s.driver.GetQueryEngine(ctx).Query(ctx, selectScheduledPayment+` WHERE owner_id=$1 ORDER BY created_at DESC`, ownerID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+	defer rows.Close()
+
+	var result []ScheduledPayment
+	for rows.Next() {
+		p, err := scanScheduledPayment(rows)
+		if err != nil {
+			return nil, pkgerrors.Wrap(api, err)
+		}
+		result = append(result, p)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, pkgerrors.Wrap(api, err)
+	}
+
+	return result, nil
+}
+
+func (s *PaymentStorage) getScheduledPayment(ctx context.Context, query string, args ...interface{}) (ScheduledPayment, error) {
+	p, err := scanScheduledPayment(s.driver.GetQueryEngine(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ScheduledPayment{}, models.ErrNotFound
+		}
+		return ScheduledPayment{}, err
+	}
+
+	return p, nil
+}
+
+func scanScheduledPayment(row pgx.Row) (ScheduledPayment, error) {
+	var p ScheduledPayment
+	err := row.Scan(
+		&p.ID,
+		&p.OwnerID,
+		&p.FromAccount,
+		&p.ToAccount,
+		&p.Amount,
+		&p.Currency,
+		&p.Description,
+		&p.RecurrenceType,
+		&p.RecurrenceInterval,
+		&p.DayOfMonth,
+		&p.CronExpr,
+		&p.StartAt,
+		&p.EndAt,
+		&p.ScheduledFor,
+		&p.NextRunAt,
+		&p.Attempt,
+		&p.MaxRetries,
+		&p.Status,
+		&p.LastRunAt,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	)
+	return p, err
+}
@@ -0,0 +1,224 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"payment/internal/models"
+	"payment/internal/repository/payment_storage"
+	"strings"
+	"time"
+)
+
+// CreateBeneficiary - saves payee after checking its account exists and verifying name against name of account,
+// payee can't receive large amounts during cooling-off period
+func (s *paymentService) CreateBeneficiary(ctx context.Context, req models.CreateBeneficiaryRequest) (models.Beneficiary, error) {
+	const op = "services.payment.CreateBeneficiary"
+
+	log := s.Logger.With(
+		slog.String("op", op),
+		slog.String("account_number", req.AccountNumber),
+	)
+
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return models.Beneficiary{}, pkgerrors.Wrap(op, models.ErrInvalidBeneficiary)
+	}
+
+	now := time.Now().UTC()
+	b := payment_storage.Beneficiary{
+		ID:              uuid.New(),
+		OwnerID:         req.OwnerID,
+		Name:            req.Name,
+		Nickname:        strings.TrimSpace(req.Nickname),
+		AccountNumber:   req.AccountNumber,
+		CoolingOffUntil: now.Add(s.CoolingOff),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	if err := s.verify(ctx, &b, now); err != nil {
+		return models.Beneficiary{}, pkgerrors.Wrap(op, err)
+	}
+
+	if err := s.PaymentStorage.CreateBeneficiary(ctx, &b); err != nil {
+		return models.Beneficiary{}, pkgerrors.Wrap(op, err)
+	}
+
+	log.Info("beneficiary created", slog.String("beneficiary_id", b.ID.String()), slog.String("verification", b.VerificationStatus))
+
+	return toBeneficiaryModel(b), nil
+}
+
+func (s *paymentService) GetBeneficiary(ctx context.Context, id uuid.UUID) (models.Beneficiary, error) {
+	const op = "services.payment.GetBeneficiary"
+
+	b, err := s.PaymentStorage.GetBeneficiary(ctx, id)
+	if err != nil {
+		return models.Beneficiary{}, pkgerrors.Wrap(op, err)
+	}
+
+	return toBeneficiaryModel(b), nil
+}
+
+func (s *paymentService) ListBeneficiaries(ctx context.Context, ownerID uuid.UUID) ([]models.Beneficiary, error) {
+	const op = "services.payment.ListBeneficiaries"
+
+	beneficiaries, err := s.PaymentStorage.ListBeneficiaries(ctx, ownerID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(op, err)
+	}
+
+	result := make([]models.Beneficiary, 0, len(beneficiaries))
+	for _, b := range beneficiaries {
+		result = append(result, toBeneficiaryModel(b))
+	}
+
+	return result, nil
+}
+
+// UpdateBeneficiary - changes name or nickname of beneficiary of owner, changed name is verified again
+func (s *paymentService) UpdateBeneficiary(ctx context.Context, id uuid.UUID, ownerID uuid.UUID, req models.UpdateBeneficiaryRequest) (models.Beneficiary, error) {
+	const op = "services.payment.UpdateBeneficiary"
+
+	b, err := s.ownBeneficiary(ctx, id, ownerID)
+	if err != nil {
+		return models.Beneficiary{}, pkgerrors.Wrap(op, err)
+	}
+
+	now := time.Now().UTC()
+	if req.Nickname != nil {
+		b.Nickname = strings.TrimSpace(*req.Nickname)
+	}
+	if req.Name != nil && strings.TrimSpace(*req.Name) != b.Name {
+		if b.Name = strings.TrimSpace(*req.Name); b.Name == "" {
+			return models.Beneficiary{}, pkgerrors.Wrap(op, models.ErrInvalidBeneficiary)
+		}
+		if err = s.verify(ctx, &b, now); err != nil {
+			return models.Beneficiary{}, pkgerrors.Wrap(op, err)
+		}
+	}
+	b.UpdatedAt = now
+
+	if err = s.PaymentStorage.UpdateBeneficiary(ctx, &b); err != nil {
+		return models.Beneficiary{}, pkgerrors.Wrap(op, err)
+	}
+
+	return toBeneficiaryModel(b), nil
+}
+
+func (s *paymentService) DeleteBeneficiary(ctx context.Context, id uuid.UUID, ownerID uuid.UUID) error {
+	const op = "services.payment.DeleteBeneficiary"
+
+	if _, err := s.ownBeneficiary(ctx, id, ownerID); err != nil {
+		return pkgerrors.Wrap(op, err)
+	}
+
+	if err := s.PaymentStorage.DeleteBeneficiary(ctx, id); err != nil {
+		return pkgerrors.Wrap(op, err)
+	}
+
+	return nil
+}
+
+// verify - matches name of beneficiary against name of its account
+func (s *paymentService) verify(ctx context.Context, b *payment_storage.Beneficiary, at time.Time) error {
+	account, err := s.AccountClient.GetAccount(ctx, b.AccountNumber)
+	if err != nil {
+		return accountError(err)
+	}
+
+	b.Currency = account.Currency
+	b.VerificationStatus = string(models.MatchName(b.Name, account.Name))
+	b.VerifiedName = account.Name
+	b.VerifiedAt = at
+
+	return nil
+}
+
+// ownBeneficiary - beneficiary of owner, others' beneficiaries are reported as not found
+func (s *paymentService) ownBeneficiary(ctx context.Context, id uuid.UUID, ownerID uuid.UUID) (payment_storage.Beneficiary, error) {
+	b, err := s.PaymentStorage.GetBeneficiary(ctx, id)
+	if err != nil {
+		return payment_storage.Beneficiary{}, err
+	}
+	if b.OwnerID != ownerID {
+		return payment_storage.Beneficiary{}, models.ErrBeneficiaryNotFound
+	}
+
+	return b, nil
+}
+
+// resolveRecipient - account number of beneficiary when payment is made to saved payee, to otherwise
+func (s *paymentService) resolveRecipient(ctx context.Context, ownerID uuid.UUID, beneficiaryID *uuid.UUID, to string) (string, error) {
+	if beneficiaryID == nil {
+		if to == "" {
+			return "", models.ErrInvalidBeneficiary
+		}
+		return to, nil
+	}
+
+	b, err := s.ownBeneficiary(ctx, *beneficiaryID, ownerID)
+	if err != nil {
+		return "", err
+	}
+
+	return b.AccountNumber, nil
+}
+
+// checkCoolingOff - amount at or above CoolingOffAmount can be sent at `at` only to beneficiary saved
+// for longer than cooling-off period or to own account, otherwise the period could be skipped by paying
+// account number directly
+func (s *paymentService) checkCoolingOff(ctx context.Context, ownerID uuid.UUID, to string, amount decimal.Decimal, at time.Time) error {
+	if amount.LessThan(s.CoolingOffAmount) {
+		return nil
+	}
+
+	b, err := s.PaymentStorage.GetBeneficiaryByAccount(ctx, ownerID, to)
+	if err != nil {
+		if !errors.Is(err, models.ErrBeneficiaryNotFound) {
+			return err
+		}
+
+		recipient, err := s.AccountClient.GetAccount(ctx, to)
+		if err != nil {
+			return accountError(err)
+		}
+		if recipient.OwnerID != ownerID {
+			return models.ErrBeneficiaryRequired
+		}
+		return nil
+	}
+
+	if at.Before(b.CoolingOffUntil) {
+		return models.ErrBeneficiaryCoolingOff
+	}
+
+	return nil
+}
+
+func toBeneficiaryModel(b payment_storage.Beneficiary) models.Beneficiary {
+	verification := models.Verification{
+		Status:     models.VerificationStatus(b.VerificationStatus),
+		VerifiedAt: b.VerifiedAt,
+	}
+	if verification.Status == models.VerificationCloseMatch {
+		verification.MatchedName = b.VerifiedName
+	}
+
+	return models.Beneficiary{
+		ID:              b.ID,
+		OwnerID:         b.OwnerID,
+		Name:            b.Name,
+		Nickname:        b.Nickname,
+		AccountNumber:   b.AccountNumber,
+		Currency:        b.Currency,
+		Verification:    verification,
+		CoolingOffUntil: b.CoolingOffUntil,
+		CreatedAt:       b.CreatedAt,
+		UpdatedAt:       b.UpdatedAt,
+	}
+}
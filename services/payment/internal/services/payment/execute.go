@@ -0,0 +1,361 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	accountclient "github.com/R1ckNash/Bank/pkg/client/account"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/R1ckNash/Bank/pkg/events"
+	"github.com/R1ckNash/Bank/pkg/transaction_manager"
+	"github.com/google/uuid"
+	"log/slog"
+	"payment/internal/models"
+	"payment/internal/repository/payment_storage"
+	slog_helper "payment/internal/slog"
+	"strings"
+	"time"
+)
+
+// ExecuteDuePayments - executes up to limit due payments one by one. Occurrence is claimed (SKIP LOCKED) and leased
+// in one short transaction, so that instances never execute it concurrently, transfer is made outside of it
+// and its result is recorded in another one.
+// Stops on the first error, e.g. when account service is unavailable, payment is claimed again when lease expires
+func (s *paymentService) ExecuteDuePayments(ctx context.Context, limit int) (int, error) {
+	const op = "services.payment.ExecuteDuePayments"
+
+	executed := 0
+	for executed < limit && ctx.Err() == nil {
+		found, err := s.executeNext(ctx)
+		if err != nil {
+			return executed, pkgerrors.Wrap(op, err)
+		}
+		if !found {
+			break
+		}
+		executed++
+	}
+
+	return executed, nil
+}
+
+// claim - occurrence taken by executeNext, transfer is set when occurrence is leased till lease
+// to be executed through account service
+type claim struct {
+	payment   payment_storage.ScheduledPayment
+	execution payment_storage.Execution
+	event     *events.ScheduledPayment
+	transfer  bool
+	lease     time.Time
+}
+
+// executeNext - claims one due payment and executes its current occurrence, false if nothing is due
+func (s *paymentService) executeNext(ctx context.Context) (bool, error) {
+	c, found, err := s.claimNext(ctx)
+	if err != nil || !found {
+		return found, err
+	}
+
+	if c.transfer {
+		// call is bounded by lease, occurrence claimed again after it gets result of this call by idempotency key
+		callCtx, cancel := context.WithTimeout(ctx, s.Lease)
+		trx, transferErr := s.AccountClient.Transfer(callCtx, accountclient.TransferRequest{
+			From:           c.payment.FromAccount,
+			To:             c.payment.ToAccount,
+			Amount:         c.payment.Amount.String(),
+			Description:    c.payment.Description,
+			InitiatedBy:    c.payment.OwnerID,
+			IdempotencyKey: idempotencyKey(c.payment),
+		})
+		cancel()
+
+		recorded, err := s.recordTransfer(ctx, &c, trx, transferErr)
+		if err != nil {
+			return false, err
+		}
+		if !recorded {
+			return true, nil
+		}
+	}
+
+	s.sendEvent(*c.event)
+
+	return true, nil
+}
+
+// claimNext - locks one due payment and checks its occurrence, denied and reviewed occurrences are recorded
+// right away, allowed one is leased: its next_run_at is moved to the end of lease so that nobody claims it
+// while transfer is made
+func (s *paymentService) claimNext(ctx context.Context) (claim, bool, error) {
+	var c claim
+	found := true
+
+	err := s.TransactionManager.RunReadCommitted(ctx, transaction_manager.ReadWrite, func(txCtx context.Context) error {
+		// TRANSANCTION SCOPE
+		now := time.Now().UTC()
+
+		p, err := s.PaymentStorage.ClaimDuePayment(txCtx, now)
+		if err != nil {
+			if errors.Is(err, models.ErrNotFound) {
+				found = false
+				return nil
+			}
+			return err
+		}
+
+		c = claim{
+			payment: p,
+			execution: payment_storage.Execution{
+				ID:           uuid.New(),
+				ScheduleID:   p.ID,
+				ScheduledFor: *p.ScheduledFor,
+				Attempt:      p.Attempt + 1,
+				ExecutedAt:   now,
+			},
+			event: &events.ScheduledPayment{
+				ScheduleID:   p.ID,
+				OwnerID:      p.OwnerID,
+				From:         p.FromAccount,
+				To:           p.ToAccount,
+				Amount:       formatAmount(p.Amount, p.Currency),
+				Currency:     p.Currency,
+				ScheduledFor: *p.ScheduledFor,
+				Attempt:      p.Attempt + 1,
+				OccurredAt:   now,
+			},
+		}
+
+		// rules are checked for every occurrence, they may have changed since payment was scheduled
+		assessment, err := s.RiskEvaluator.Evaluate(txCtx, models.RiskInput{
+			OwnerID:  p.OwnerID,
+			From:     p.FromAccount,
+			To:       p.ToAccount,
+			Amount:   p.Amount,
+			Currency: p.Currency,
+			At:       now,
+		})
+		if err != nil {
+			return err
+		}
+
+		switch assessment.Decision {
+		case models.RiskDeny:
+			c.execution.Status = string(models.ExecutionDenied)
+			c.execution.Error = riskError(assessment)
+			c.event.EventType = events.ScheduledPaymentFailed
+			c.event.Reason = models.ErrPaymentDenied.Error()
+			advance(&p, now)
+		case models.RiskReview:
+			// operator decides on occurrence in review queue, approved one is executed as payment
+			review, err := s.reviewOccurrence(txCtx, p, assessment, now)
+			if err != nil {
+				return err
+			}
+			c.execution.Status = string(models.ExecutionPendingReview)
+			c.execution.PaymentID = &review.ID
+			c.event.EventType = events.ScheduledPaymentPendingReview
+			c.event.PaymentID = &review.ID
+			advance(&p, now)
+		default:
+			// stored timestamps have microsecond precision, lease is compared with stored one
+			c.transfer = true
+			c.lease = now.Add(s.Lease).Truncate(time.Microsecond)
+			p.NextRunAt = &c.lease
+			p.UpdatedAt = now
+			return s.PaymentStorage.UpdateSchedule(txCtx, &p)
+		}
+
+		if err = s.PaymentStorage.CreateExecution(txCtx, &c.execution); err != nil {
+			return err
+		}
+
+		p.LastRunAt = &now
+		p.UpdatedAt = now
+		if err = s.PaymentStorage.UpdateSchedule(txCtx, &p); err != nil {
+			return err
+		}
+
+		c.event.NextRunAt = p.NextRunAt
+		return nil
+		// TRANSANCTION SCOPE
+	})
+	if err != nil {
+		return claim{}, false, err
+	}
+
+	return c, found, nil
+}
+
+// recordTransfer - records result of transfer of leased occurrence, false if lease has expired and occurrence
+// was claimed again, result is recorded by that claim then. Schedule cancelled during transfer keeps its status
+func (s *paymentService) recordTransfer(ctx context.Context, c *claim, trx accountclient.Transaction, transferErr error) (bool, error) {
+	recorded := true
+
+	err := s.TransactionManager.RunReadCommitted(ctx, transaction_manager.ReadWrite, func(txCtx context.Context) error {
+		// TRANSANCTION SCOPE
+		now := time.Now().UTC()
+
+		p, err := s.PaymentStorage.GetScheduledPaymentForUpdate(txCtx, c.payment.ID)
+		if err != nil {
+			return err
+		}
+
+		sameOccurrence := p.Attempt == c.payment.Attempt && p.ScheduledFor != nil && p.ScheduledFor.Equal(*c.payment.ScheduledFor)
+		cancelled := p.Status == string(models.ScheduleCancelled)
+		leased := p.Status == string(models.ScheduleActive) && p.NextRunAt != nil && p.NextRunAt.Equal(c.lease)
+		if !sameOccurrence || !cancelled && !leased {
+			recorded = false
+			return nil
+		}
+
+		c.execution.ExecutedAt = now
+		c.event.OccurredAt = now
+		if err = s.transferOutcome(&p, &c.execution, c.event, trx, transferErr, now); err != nil {
+			return err
+		}
+
+		if err = s.PaymentStorage.CreateExecution(txCtx, &c.execution); err != nil {
+			return err
+		}
+
+		if cancelled {
+			c.event.NextRunAt = nil
+			return nil
+		}
+
+		p.LastRunAt = &now
+		p.UpdatedAt = now
+		if err = s.PaymentStorage.UpdateSchedule(txCtx, &p); err != nil {
+			return err
+		}
+
+		c.event.NextRunAt = p.NextRunAt
+		return nil
+		// TRANSANCTION SCOPE
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return recorded, nil
+}
+
+// transferOutcome - applies result of transfer to occurrence, failure that retry can't fix is recorded
+// and schedule moves on, other errors are returned and occurrence is claimed again after lease
+func (s *paymentService) transferOutcome(p *payment_storage.ScheduledPayment, execution *payment_storage.Execution,
+	event *events.ScheduledPayment, trx accountclient.Transaction, err error, now time.Time) error {
+	switch {
+	case err == nil:
+		execution.Status = string(models.ExecutionSucceeded)
+		execution.TransactionID = &trx.ID
+		event.EventType = events.ScheduledPaymentExecuted
+		event.TransactionID = &trx.ID
+		advance(p, now)
+	case errors.Is(err, accountclient.ErrInsufficientFunds) && p.Attempt < p.MaxRetries:
+		execution.Status = string(models.ExecutionInsufficientFunds)
+		execution.Error = err.Error()
+		event.EventType = events.ScheduledPaymentRetryScheduled
+		event.Reason = models.ErrInsufficientFunds.Error()
+		p.Attempt++
+		next := now.Add(s.RetryDelay)
+		p.NextRunAt = &next
+	case errors.Is(err, accountclient.ErrInsufficientFunds),
+		errors.Is(err, accountclient.ErrAccountNotFound),
+		errors.Is(err, accountclient.ErrAccountNotActive),
+		errors.Is(err, accountclient.ErrLimitExceeded),
+		errors.Is(err, accountclient.ErrForbidden),
+		errors.Is(err, accountclient.ErrRejected):
+		// occurrence is given up, schedule moves on to the next one
+		execution.Status = string(models.ExecutionFailed)
+		execution.Error = err.Error()
+		event.EventType = events.ScheduledPaymentFailed
+		event.Reason = accountError(err).Error()
+		advance(p, now)
+	default:
+		return err
+	}
+
+	return nil
+}
+
+// reviewOccurrence - puts occurrence to review queue as payment, occurrence key keeps it from being queued twice
+func (s *paymentService) reviewOccurrence(txCtx context.Context, p payment_storage.ScheduledPayment,
+	assessment models.RiskAssessment, now time.Time) (payment_storage.Payment, error) {
+	key := idempotencyKey(p)
+
+	review := payment_storage.Payment{
+		ID:             uuid.New(),
+		OwnerID:        p.OwnerID,
+		FromAccount:    p.FromAccount,
+		ToAccount:      p.ToAccount,
+		Amount:         p.Amount,
+		Currency:       p.Currency,
+		Description:    p.Description,
+		Status:         string(models.PaymentPendingReview),
+		RiskDecision:   string(assessment.Decision),
+		RiskReasons:    assessment.Reasons,
+		IdempotencyKey: &key,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if review.RiskReasons == nil {
+		review.RiskReasons = []string{}
+	}
+
+	if err := s.PaymentStorage.CreatePayment(txCtx, &review); err != nil {
+		return payment_storage.Payment{}, err
+	}
+
+	return review, nil
+}
+
+// riskError - denial with names of matched rules
+func riskError(assessment models.RiskAssessment) string {
+	if len(assessment.Reasons) == 0 {
+		return models.ErrPaymentDenied.Error()
+	}
+	return models.ErrPaymentDenied.Error() + ": " + strings.Join(assessment.Reasons, ", ")
+}
+
+// advance - moves schedule to the next occurrence after now, missed occurrences are skipped;
+// schedule is completed when there are no more occurrences
+func advance(p *payment_storage.ScheduledPayment, now time.Time) {
+	p.Attempt = 0
+
+	after := now
+	if p.ScheduledFor != nil && p.ScheduledFor.After(now) {
+		after = *p.ScheduledFor
+	}
+
+	next, ok := recurrenceOf(*p).Next(p.StartAt, after)
+	if !ok || (p.EndAt != nil && next.After(*p.EndAt)) {
+		p.Status = string(models.ScheduleCompleted)
+		p.NextRunAt = nil
+		return
+	}
+
+	p.ScheduledFor = &next
+	p.NextRunAt = &next
+}
+
+// idempotencyKey - one transfer per occurrence, retries of occurrence reuse the key
+func idempotencyKey(p payment_storage.ScheduledPayment) string {
+	return "scheduled-payment:" + p.ID.String() + ":" + p.ScheduledFor.UTC().Format(time.RFC3339)
+}
+
+func (s *paymentService) sendEvent(event events.ScheduledPayment) {
+	log := s.Logger.With(
+		slog.String("schedule_id", event.ScheduleID.String()),
+		slog.String("event_type", event.EventType),
+	)
+
+	eventJson, err := json.Marshal(event)
+	if err != nil {
+		log.Error("could not marshall scheduled payment event", slog_helper.Err(err))
+		return
+	}
+
+	if err = s.EventProducer.SendMessage(events.TopicScheduledPayment, event.ScheduleID.String(), eventJson); err != nil {
+		log.Error("could not send scheduled payment event", slog_helper.Err(err))
+	}
+}
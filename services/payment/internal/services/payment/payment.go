@@ -0,0 +1,114 @@
+package payment
+
+import (
+	"context"
+	accountclient "github.com/R1ckNash/Bank/pkg/client/account"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"payment/internal/models"
+	"payment/internal/repository/payment_storage"
+	"time"
+)
+
+//go:generate mockery --name=PaymentService --filename=payment_service_mock.go --disable-version-string
+type PaymentService interface {
+	CreateScheduledPayment(ctx context.Context, req models.CreateScheduledPaymentRequest) (models.ScheduledPayment, error)
+	GetScheduledPayment(ctx context.Context, id uuid.UUID) (models.ScheduledPayment, error)
+	ListScheduledPayments(ctx context.Context, ownerID uuid.UUID) ([]models.ScheduledPayment, error)
+	CancelScheduledPayment(ctx context.Context, id uuid.UUID, ownerID uuid.UUID) (models.ScheduledPayment, error)
+	ListExecutions(ctx context.Context, id uuid.UUID) ([]models.Execution, error)
+	ExecuteDuePayments(ctx context.Context, limit int) (int, error)
+	CreatePayment(ctx context.Context, req models.CreatePaymentRequest) (models.Payment, error)
+	GetPayment(ctx context.Context, id uuid.UUID) (models.Payment, error)
+	ListPayments(ctx context.Context, ownerID uuid.UUID) ([]models.Payment, error)
+	ListReviews(ctx context.Context) ([]models.Payment, error)
+	ReviewPayment(ctx context.Context, id uuid.UUID, decision models.ReviewDecision) (models.Payment, error)
+	CreateBeneficiary(ctx context.Context, req models.CreateBeneficiaryRequest) (models.Beneficiary, error)
+	GetBeneficiary(ctx context.Context, id uuid.UUID) (models.Beneficiary, error)
+	ListBeneficiaries(ctx context.Context, ownerID uuid.UUID) ([]models.Beneficiary, error)
+	UpdateBeneficiary(ctx context.Context, id uuid.UUID, ownerID uuid.UUID, req models.UpdateBeneficiaryRequest) (models.Beneficiary, error)
+	DeleteBeneficiary(ctx context.Context, id uuid.UUID, ownerID uuid.UUID) error
+}
+
+//go:generate mockery --name=PaymentStorage --filename=payment_storage_mock.go --disable-version-string
+type PaymentStorage interface {
+	CreateScheduledPayment(ctx context.Context, p *payment_storage.ScheduledPayment) error
+	UpdateSchedule(ctx context.Context, p *payment_storage.ScheduledPayment) error
+	GetScheduledPayment(ctx context.Context, id uuid.UUID) (payment_storage.ScheduledPayment, error)
+	GetScheduledPaymentForUpdate(ctx context.Context, id uuid.UUID) (payment_storage.ScheduledPayment, error)
+	ClaimDuePayment(ctx context.Context, at time.Time) (payment_storage.ScheduledPayment, error)
+	ListScheduledPayments(ctx context.Context, ownerID uuid.UUID) ([]payment_storage.ScheduledPayment, error)
+	CreateExecution(ctx context.Context, e *payment_storage.Execution) error
+	ListExecutions(ctx context.Context, scheduleID uuid.UUID, limit int) ([]payment_storage.Execution, error)
+	CreatePayment(ctx context.Context, p *payment_storage.Payment) error
+	UpdatePayment(ctx context.Context, p *payment_storage.Payment) error
+	GetPayment(ctx context.Context, id uuid.UUID) (payment_storage.Payment, error)
+	GetPaymentForUpdate(ctx context.Context, id uuid.UUID) (payment_storage.Payment, error)
+	GetPaymentByIdempotencyKey(ctx context.Context, ownerID uuid.UUID, key string) (payment_storage.Payment, error)
+	ListPayments(ctx context.Context, ownerID uuid.UUID, limit int) ([]payment_storage.Payment, error)
+	ListPaymentsByStatus(ctx context.Context, status string, limit int) ([]payment_storage.Payment, error)
+	CreateBeneficiary(ctx context.Context, b *payment_storage.Beneficiary) error
+	UpdateBeneficiary(ctx context.Context, b *payment_storage.Beneficiary) error
+	DeleteBeneficiary(ctx context.Context, id uuid.UUID) error
+	GetBeneficiary(ctx context.Context, id uuid.UUID) (payment_storage.Beneficiary, error)
+	GetBeneficiaryByAccount(ctx context.Context, ownerID uuid.UUID, accountNumber string) (payment_storage.Beneficiary, error)
+	ListBeneficiaries(ctx context.Context, ownerID uuid.UUID) ([]payment_storage.Beneficiary, error)
+}
+
+// RiskEvaluator - pre-authorization checks of payment
+//
+//go:generate mockery --name=RiskEvaluator
+type RiskEvaluator interface {
+	Evaluate(ctx context.Context, in models.RiskInput) (models.RiskAssessment, error)
+}
+
+// AccountClient - internal API of account service
+//
+//go:generate mockery --name=AccountClient
+type AccountClient interface {
+	GetAccount(ctx context.Context, number string) (accountclient.Account, error)
+	Transfer(ctx context.Context, req accountclient.TransferRequest) (accountclient.Transaction, error)
+}
+
+//go:generate mockery --name=EventProducer
+type EventProducer interface {
+	SendMessage(topic, key string, message []byte) error
+}
+
+// TransactionManager trx manager
+type TransactionManager interface {
+	RunReadCommitted(ctx context.Context, accessMode pgx.TxAccessMode, f func(ctx context.Context) error) error
+}
+
+type Deps struct {
+	PaymentStorage
+	TransactionManager
+	EventProducer
+	AccountClient
+	RiskEvaluator
+	Logger *slog.Logger
+
+	// RetryDelay - pause before next attempt of occurrence that failed with insufficient funds,
+	// DefaultMaxRetries - number of such attempts for payments created without explicit value
+	RetryDelay        time.Duration
+	DefaultMaxRetries int
+
+	// Lease - time occurrence being transferred isn't claimed again, bounds call of account service
+	Lease time.Duration
+
+	// CoolingOff - period after adding beneficiary when it can't receive CoolingOffAmount or more
+	CoolingOff       time.Duration
+	CoolingOffAmount decimal.Decimal
+}
+
+type paymentService struct {
+	Deps
+}
+
+func NewPaymentService(d Deps) PaymentService {
+	return &paymentService{
+		Deps: d,
+	}
+}
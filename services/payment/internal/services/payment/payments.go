@@ -0,0 +1,307 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	accountclient "github.com/R1ckNash/Bank/pkg/client/account"
+	"github.com/R1ckNash/Bank/pkg/currency"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/R1ckNash/Bank/pkg/transaction_manager"
+	"github.com/google/uuid"
+	"log/slog"
+	"payment/internal/models"
+	"payment/internal/repository/payment_storage"
+	slog_helper "payment/internal/slog"
+	"time"
+)
+
+// paymentsLimit - the latest payments returned by ListPayments and ListReviews
+const paymentsLimit = 100
+
+// CreatePayment - checks payment against risk rules, allowed payment is executed at once,
+// the one requiring review waits in queue for operator decision, denied one is only recorded
+func (s *paymentService) CreatePayment(ctx context.Context, req models.CreatePaymentRequest) (models.Payment, error) {
+	const op = "services.payment.CreatePayment"
+
+	log := s.Logger.With(
+		slog.String("op", op),
+		slog.String("from", req.From),
+		slog.String("to", req.To),
+		slog.String("idempotency_key", req.IdempotencyKey),
+	)
+
+	if !req.Amount.IsPositive() {
+		return models.Payment{}, pkgerrors.Wrap(op, models.ErrInvalidAmount)
+	}
+
+	if req.IdempotencyKey != "" {
+		existing, err := s.PaymentStorage.GetPaymentByIdempotencyKey(ctx, req.OwnerID, req.IdempotencyKey)
+		if err == nil {
+			log.Info("payment already exists", slog.String("payment_id", existing.ID.String()))
+			return s.resume(ctx, existing)
+		}
+		if !errors.Is(err, models.ErrPaymentNotFound) {
+			return models.Payment{}, pkgerrors.Wrap(op, err)
+		}
+	}
+
+	to, err := s.resolveRecipient(ctx, req.OwnerID, req.BeneficiaryID, req.To)
+	if err != nil {
+		return models.Payment{}, pkgerrors.Wrap(op, err)
+	}
+	if req.To = to; req.From == req.To {
+		return models.Payment{}, pkgerrors.Wrap(op, models.ErrSameAccount)
+	}
+
+	from, err := s.AccountClient.GetAccount(ctx, req.From)
+	if err != nil {
+		return models.Payment{}, pkgerrors.Wrap(op, accountError(err))
+	}
+	// don't reveal existence of other users' accounts
+	if from.OwnerID != req.OwnerID {
+		return models.Payment{}, pkgerrors.Wrap(op, models.ErrAccountNotFound)
+	}
+
+	cur, err := currency.Lookup(from.Currency)
+	if err != nil {
+		return models.Payment{}, pkgerrors.Wrap(op, err)
+	}
+	if !cur.Round(req.Amount).Equal(req.Amount) {
+		return models.Payment{}, pkgerrors.Wrap(op, models.ErrInvalidAmount)
+	}
+
+	now := time.Now().UTC()
+	if err = s.checkCoolingOff(ctx, req.OwnerID, req.To, req.Amount, now); err != nil {
+		return models.Payment{}, pkgerrors.Wrap(op, err)
+	}
+
+	assessment, err := s.RiskEvaluator.Evaluate(ctx, models.RiskInput{
+		OwnerID:  req.OwnerID,
+		From:     req.From,
+		To:       req.To,
+		Amount:   req.Amount,
+		Currency: cur.Code,
+		At:       now,
+	})
+	if err != nil {
+		return models.Payment{}, pkgerrors.Wrap(op, err)
+	}
+
+	p := payment_storage.Payment{
+		ID:           uuid.New(),
+		OwnerID:      req.OwnerID,
+		FromAccount:  req.From,
+		ToAccount:    req.To,
+		Amount:       req.Amount,
+		Currency:     cur.Code,
+		Description:  req.Description,
+		Status:       string(statusOf(assessment.Decision)),
+		RiskDecision: string(assessment.Decision),
+		RiskReasons:  assessment.Reasons,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if p.RiskReasons == nil {
+		p.RiskReasons = []string{}
+	}
+	if req.IdempotencyKey != "" {
+		p.IdempotencyKey = &req.IdempotencyKey
+	}
+
+	if err = s.PaymentStorage.CreatePayment(ctx, &p); err != nil {
+		// concurrent request with same key won the race, its row may not be on replica yet
+		if req.IdempotencyKey != "" && errors.Is(err, models.ErrAlreadyExists) {
+			existing, getErr := s.PaymentStorage.GetPaymentByIdempotencyKey(transaction_manager.WithPrimary(ctx), req.OwnerID, req.IdempotencyKey)
+			if getErr == nil {
+				return toPaymentModel(existing), nil
+			}
+		}
+		return models.Payment{}, pkgerrors.Wrap(op, err)
+	}
+
+	log.Info("payment risk assessed",
+		slog.String("payment_id", p.ID.String()),
+		slog.String("decision", p.RiskDecision),
+		slog.Any("reasons", p.RiskReasons),
+	)
+
+	result, err := s.resume(ctx, p)
+	if err != nil {
+		return result, pkgerrors.Wrap(op, err)
+	}
+
+	return result, nil
+}
+
+func (s *paymentService) GetPayment(ctx context.Context, id uuid.UUID) (models.Payment, error) {
+	const op = "services.payment.GetPayment"
+
+	p, err := s.PaymentStorage.GetPayment(ctx, id)
+	if err != nil {
+		return models.Payment{}, pkgerrors.Wrap(op, err)
+	}
+
+	return toPaymentModel(p), nil
+}
+
+func (s *paymentService) ListPayments(ctx context.Context, ownerID uuid.UUID) ([]models.Payment, error) {
+	const op = "services.payment.ListPayments"
+
+	payments, err := s.PaymentStorage.ListPayments(ctx, ownerID, paymentsLimit)
+	if err != nil {
+		return nil, pkgerrors.Wrap(op, err)
+	}
+
+	return toPaymentModels(payments), nil
+}
+
+// ListReviews - review queue, oldest first
+func (s *paymentService) ListReviews(ctx context.Context) ([]models.Payment, error) {
+	const op = "services.payment.ListReviews"
+
+	payments, err := s.PaymentStorage.ListPaymentsByStatus(ctx, string(models.PaymentPendingReview), paymentsLimit)
+	if err != nil {
+		return nil, pkgerrors.Wrap(op, err)
+	}
+
+	return toPaymentModels(payments), nil
+}
+
+// ReviewPayment - operator approves payment from review queue, it's executed then, or rejects it
+func (s *paymentService) ReviewPayment(ctx context.Context, id uuid.UUID, decision models.ReviewDecision) (models.Payment, error) {
+	const op = "services.payment.ReviewPayment"
+
+	log := s.Logger.With(
+		slog.String("op", op),
+		slog.String("payment_id", id.String()),
+		slog.String("action", string(decision.Action)),
+		slog.String("reviewed_by", decision.ReviewedBy),
+	)
+
+	var p payment_storage.Payment
+	err := s.TransactionManager.RunReadCommitted(ctx, transaction_manager.ReadWrite, func(txCtx context.Context) error {
+		// TRANSANCTION SCOPE
+		var err error
+		if p, err = s.PaymentStorage.GetPaymentForUpdate(txCtx, id); err != nil {
+			return err
+		}
+		if p.Status != string(models.PaymentPendingReview) {
+			return models.ErrNotPendingReview
+		}
+
+		now := time.Now().UTC()
+		p.Status = string(models.PaymentProcessing)
+		if decision.Action == models.ReviewReject {
+			p.Status = string(models.PaymentRejected)
+		}
+		p.ReviewedBy = &decision.ReviewedBy
+		p.ReviewComment = decision.Comment
+		p.ReviewedAt = &now
+		p.UpdatedAt = now
+
+		return s.PaymentStorage.UpdatePayment(txCtx, &p)
+		// TRANSANCTION SCOPE
+	})
+	if err != nil {
+		log.Warn("error reviewing payment", slog_helper.Err(err))
+		return models.Payment{}, pkgerrors.Wrap(op, err)
+	}
+
+	log.Info("payment reviewed")
+
+	result, err := s.resume(ctx, p)
+	if err != nil {
+		return result, pkgerrors.Wrap(op, err)
+	}
+
+	return result, nil
+}
+
+// resume - executes processing payment, payments in other statuses are returned as they are;
+// denied payment is reported with models.ErrPaymentDenied
+func (s *paymentService) resume(ctx context.Context, p payment_storage.Payment) (models.Payment, error) {
+	switch models.PaymentStatus(p.Status) {
+	case models.PaymentProcessing:
+		return s.execute(ctx, p)
+	case models.PaymentDenied:
+		return toPaymentModel(p), models.ErrPaymentDenied
+	default:
+		return toPaymentModel(p), nil
+	}
+}
+
+// execute - transfers money through account service, payment id is idempotency key of transfer, so
+// payment left in processing when account service is unavailable can be safely executed again
+func (s *paymentService) execute(ctx context.Context, p payment_storage.Payment) (models.Payment, error) {
+	trx, err := s.AccountClient.Transfer(ctx, accountclient.TransferRequest{
+		From:           p.FromAccount,
+		To:             p.ToAccount,
+		Amount:         p.Amount.String(),
+		Description:    p.Description,
+		InitiatedBy:    p.OwnerID,
+		IdempotencyKey: "payment:" + p.ID.String(),
+	})
+	if err != nil && errors.Is(accountError(err), models.ErrAccountUnavailable) {
+		return toPaymentModel(p), accountError(err)
+	}
+
+	var result error
+	if err != nil {
+		result = accountError(err)
+		p.Status = string(models.PaymentFailed)
+		p.Error = result.Error()
+	} else {
+		p.Status = string(models.PaymentCompleted)
+		p.TransactionID = &trx.ID
+	}
+	p.UpdatedAt = time.Now().UTC()
+
+	if err = s.PaymentStorage.UpdatePayment(ctx, &p); err != nil {
+		return toPaymentModel(p), err
+	}
+
+	return toPaymentModel(p), result
+}
+
+// statusOf - initial status of payment with risk decision
+func statusOf(decision models.RiskDecision) models.PaymentStatus {
+	switch decision {
+	case models.RiskDeny:
+		return models.PaymentDenied
+	case models.RiskReview:
+		return models.PaymentPendingReview
+	default:
+		return models.PaymentProcessing
+	}
+}
+
+func toPaymentModels(payments []payment_storage.Payment) []models.Payment {
+	result := make([]models.Payment, 0, len(payments))
+	for _, p := range payments {
+		result = append(result, toPaymentModel(p))
+	}
+	return result
+}
+
+func toPaymentModel(p payment_storage.Payment) models.Payment {
+	return models.Payment{
+		ID:          p.ID,
+		OwnerID:     p.OwnerID,
+		From:        p.FromAccount,
+		To:          p.ToAccount,
+		Amount:      formatAmount(p.Amount, p.Currency),
+		Currency:    p.Currency,
+		Description: p.Description,
+		Status:      models.PaymentStatus(p.Status),
+		Risk: models.RiskAssessment{
+			Decision: models.RiskDecision(p.RiskDecision),
+			Reasons:  p.RiskReasons,
+		},
+		TransactionID: p.TransactionID,
+		Error:         p.Error,
+		ReviewedBy:    p.ReviewedBy,
+		ReviewComment: p.ReviewComment,
+		ReviewedAt:    p.ReviewedAt,
+		CreatedAt:     p.CreatedAt,
+	}
+}
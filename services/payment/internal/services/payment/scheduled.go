@@ -0,0 +1,264 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	accountclient "github.com/R1ckNash/Bank/pkg/client/account"
+	"github.com/R1ckNash/Bank/pkg/currency"
+	pkgerrors "github.com/R1ckNash/Bank/pkg/errors"
+	"github.com/R1ckNash/Bank/pkg/transaction_manager"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"payment/internal/models"
+	"payment/internal/repository/payment_storage"
+	"time"
+)
+
+// executionsLimit - the latest attempts returned by ListExecutions
+const executionsLimit = 100
+
+// CreateScheduledPayment - source account must belong to owner, amount is in its currency
+func (s *paymentService) CreateScheduledPayment(ctx context.Context, req models.CreateScheduledPaymentRequest) (models.ScheduledPayment, error) {
+	const op = "services.payment.CreateScheduledPayment"
+
+	if !req.Amount.IsPositive() {
+		return models.ScheduledPayment{}, pkgerrors.Wrap(op, models.ErrInvalidAmount)
+	}
+	if err := req.Recurrence.Validate(); err != nil {
+		return models.ScheduledPayment{}, pkgerrors.Wrap(op, err)
+	}
+
+	now := time.Now().UTC()
+	if req.StartAt.IsZero() {
+		req.StartAt = now
+	}
+	if req.EndAt != nil && !req.EndAt.After(req.StartAt) {
+		return models.ScheduledPayment{}, pkgerrors.Wrap(op, models.ErrInvalidSchedule)
+	}
+
+	maxRetries := s.DefaultMaxRetries
+	if req.MaxRetries != nil {
+		if *req.MaxRetries < 0 {
+			return models.ScheduledPayment{}, pkgerrors.Wrap(op, models.ErrInvalidSchedule)
+		}
+		maxRetries = *req.MaxRetries
+	}
+
+	first, ok := req.Recurrence.Next(req.StartAt, req.StartAt.Add(-time.Nanosecond))
+	if !ok || (req.EndAt != nil && first.After(*req.EndAt)) {
+		return models.ScheduledPayment{}, pkgerrors.Wrap(op, models.ErrInvalidSchedule)
+	}
+
+	to, err := s.resolveRecipient(ctx, req.OwnerID, req.BeneficiaryID, req.To)
+	if err != nil {
+		return models.ScheduledPayment{}, pkgerrors.Wrap(op, err)
+	}
+	if req.To = to; req.From == req.To {
+		return models.ScheduledPayment{}, pkgerrors.Wrap(op, models.ErrSameAccount)
+	}
+
+	from, err := s.AccountClient.GetAccount(ctx, req.From)
+	if err != nil {
+		return models.ScheduledPayment{}, pkgerrors.Wrap(op, accountError(err))
+	}
+	// don't reveal existence of other users' accounts
+	if from.OwnerID != req.OwnerID {
+		return models.ScheduledPayment{}, pkgerrors.Wrap(op, models.ErrAccountNotFound)
+	}
+	if from.Status != "active" {
+		return models.ScheduledPayment{}, pkgerrors.Wrap(op, models.ErrAccountNotActive)
+	}
+	if _, err = s.AccountClient.GetAccount(ctx, req.To); err != nil {
+		return models.ScheduledPayment{}, pkgerrors.Wrap(op, accountError(err))
+	}
+
+	cur, err := currency.Lookup(from.Currency)
+	if err != nil {
+		return models.ScheduledPayment{}, pkgerrors.Wrap(op, err)
+	}
+	if !cur.Round(req.Amount).Equal(req.Amount) {
+		return models.ScheduledPayment{}, pkgerrors.Wrap(op, models.ErrInvalidAmount)
+	}
+	if err = s.checkCoolingOff(ctx, req.OwnerID, req.To, req.Amount, first); err != nil {
+		return models.ScheduledPayment{}, pkgerrors.Wrap(op, err)
+	}
+
+	p := payment_storage.ScheduledPayment{
+		ID:                 uuid.New(),
+		OwnerID:            req.OwnerID,
+		FromAccount:        req.From,
+		ToAccount:          req.To,
+		Amount:             req.Amount,
+		Currency:           cur.Code,
+		Description:        req.Description,
+		RecurrenceType:     string(req.Recurrence.Type),
+		RecurrenceInterval: max(req.Recurrence.Interval, 1),
+		CronExpr:           req.Recurrence.Cron,
+		StartAt:            req.StartAt.UTC(),
+		EndAt:              req.EndAt,
+		ScheduledFor:       &first,
+		NextRunAt:          &first,
+		MaxRetries:         maxRetries,
+		Status:             string(models.ScheduleActive),
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+	if req.Recurrence.Type == models.RecurrenceMonthly {
+		p.DayOfMonth = &req.Recurrence.DayOfMonth
+	}
+
+	if err = s.PaymentStorage.CreateScheduledPayment(ctx, &p); err != nil {
+		return models.ScheduledPayment{}, pkgerrors.Wrap(op, err)
+	}
+
+	return toScheduledPaymentModel(p), nil
+}
+
+func (s *paymentService) GetScheduledPayment(ctx context.Context, id uuid.UUID) (models.ScheduledPayment, error) {
+	const op = "services.payment.GetScheduledPayment"
+
+	p, err := s.PaymentStorage.GetScheduledPayment(ctx, id)
+	if err != nil {
+		return models.ScheduledPayment{}, pkgerrors.Wrap(op, err)
+	}
+
+	return toScheduledPaymentModel(p), nil
+}
+
+func (s *paymentService) ListScheduledPayments(ctx context.Context, ownerID uuid.UUID) ([]models.ScheduledPayment, error) {
+	const op = "services.payment.ListScheduledPayments"
+
+	payments, err := s.PaymentStorage.ListScheduledPayments(ctx, ownerID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(op, err)
+	}
+
+	result := make([]models.ScheduledPayment, 0, len(payments))
+	for _, p := range payments {
+		result = append(result, toScheduledPaymentModel(p))
+	}
+
+	return result, nil
+}
+
+// CancelScheduledPayment - stops future occurrences, waits for execution in progress
+func (s *paymentService) CancelScheduledPayment(ctx context.Context, id uuid.UUID, ownerID uuid.UUID) (models.ScheduledPayment, error) {
+	const op = "services.payment.CancelScheduledPayment"
+
+	var result payment_storage.ScheduledPayment
+	err := s.TransactionManager.RunReadCommitted(ctx, transaction_manager.ReadWrite, func(txCtx context.Context) error {
+		// TRANSANCTION SCOPE
+		p, err := s.PaymentStorage.GetScheduledPaymentForUpdate(txCtx, id)
+		if err != nil {
+			return err
+		}
+		if p.OwnerID != ownerID {
+			return models.ErrNotFound
+		}
+		if p.Status != string(models.ScheduleActive) {
+			return models.ErrNotActive
+		}
+
+		p.Status = string(models.ScheduleCancelled)
+		p.NextRunAt = nil
+		p.UpdatedAt = time.Now().UTC()
+		if err = s.PaymentStorage.UpdateSchedule(txCtx, &p); err != nil {
+			return err
+		}
+
+		result = p
+		return nil
+		// TRANSANCTION SCOPE
+	})
+	if err != nil {
+		return models.ScheduledPayment{}, pkgerrors.Wrap(op, err)
+	}
+
+	return toScheduledPaymentModel(result), nil
+}
+
+func (s *paymentService) ListExecutions(ctx context.Context, id uuid.UUID) ([]models.Execution, error) {
+	const op = "services.payment.ListExecutions"
+
+	executions, err := s.PaymentStorage.ListExecutions(ctx, id, executionsLimit)
+	if err != nil {
+		return nil, pkgerrors.Wrap(op, err)
+	}
+
+	result := make([]models.Execution, 0, len(executions))
+	for _, e := range executions {
+		result = append(result, models.Execution{
+			ID:            e.ID,
+			ScheduledFor:  e.ScheduledFor,
+			Attempt:       e.Attempt,
+			Status:        models.ExecutionStatus(e.Status),
+			TransactionID: e.TransactionID,
+			PaymentID:     e.PaymentID,
+			Error:         e.Error,
+			ExecutedAt:    e.ExecutedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// accountError - maps rejection of account service to domain error
+func accountError(err error) error {
+	switch {
+	case errors.Is(err, accountclient.ErrAccountNotFound):
+		return models.ErrAccountNotFound
+	case errors.Is(err, accountclient.ErrAccountNotActive):
+		return models.ErrAccountNotActive
+	case errors.Is(err, accountclient.ErrLimitExceeded):
+		return models.ErrLimitExceeded
+	case errors.Is(err, accountclient.ErrUnavailable):
+		return errors.Join(models.ErrAccountUnavailable, err)
+	default:
+		return err
+	}
+}
+
+func recurrenceOf(p payment_storage.ScheduledPayment) models.Recurrence {
+	r := models.Recurrence{
+		Type:     models.RecurrenceType(p.RecurrenceType),
+		Interval: p.RecurrenceInterval,
+		Cron:     p.CronExpr,
+	}
+	if p.DayOfMonth != nil {
+		r.DayOfMonth = *p.DayOfMonth
+	}
+
+	return r
+}
+
+// formatAmount - amount with minor units of its currency
+func formatAmount(amount decimal.Decimal, code string) string {
+	cur, err := currency.Lookup(code)
+	if err != nil {
+		return amount.String()
+	}
+
+	return cur.Format(amount)
+}
+
+func toScheduledPaymentModel(p payment_storage.ScheduledPayment) models.ScheduledPayment {
+	return models.ScheduledPayment{
+		ID:           p.ID,
+		OwnerID:      p.OwnerID,
+		From:         p.FromAccount,
+		To:           p.ToAccount,
+		Amount:       formatAmount(p.Amount, p.Currency),
+		Currency:     p.Currency,
+		Description:  p.Description,
+		Recurrence:   recurrenceOf(p),
+		StartAt:      p.StartAt,
+		EndAt:        p.EndAt,
+		ScheduledFor: p.ScheduledFor,
+		NextRunAt:    p.NextRunAt,
+		Attempt:      p.Attempt,
+		MaxRetries:   p.MaxRetries,
+		Status:       models.ScheduleStatus(p.Status),
+		LastRunAt:    p.LastRunAt,
+		CreatedAt:    p.CreatedAt,
+	}
+}
@@ -0,0 +1,63 @@
+package payment
+
+import (
+	"context"
+	"log/slog"
+	slog_helper "payment/internal/slog"
+	"time"
+)
+
+// DuePaymentsExecutor - executes scheduled payments whose time has come
+type DuePaymentsExecutor interface {
+	ExecuteDuePayments(ctx context.Context, limit int) (int, error)
+}
+
+// Scheduler - periodically executes due scheduled payments, safe to run on every instance
+type Scheduler struct {
+	executor  DuePaymentsExecutor
+	logger    *slog.Logger
+	interval  time.Duration
+	batchSize int
+}
+
+func NewScheduler(executor DuePaymentsExecutor, logger *slog.Logger, interval time.Duration, batchSize int) *Scheduler {
+	return &Scheduler{
+		executor:  executor,
+		logger:    logger,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	s.logger.Info("payment scheduler started", slog.Duration("interval", s.interval))
+
+	for {
+		select {
+		case <-ticker.C:
+			s.tick(ctx)
+		case <-ctx.Done():
+			s.logger.Info("payment scheduler stopped")
+			return
+		}
+	}
+}
+
+// tick - executes batches until there are no due payments left
+func (s *Scheduler) tick(ctx context.Context) {
+	for ctx.Err() == nil {
+		executed, err := s.executor.ExecuteDuePayments(ctx, s.batchSize)
+		if executed > 0 {
+			s.logger.Info("scheduled payments executed", slog.Int("count", executed))
+		}
+		if err != nil {
+			s.logger.Error("failed to execute scheduled payments", slog_helper.Err(err))
+			return
+		}
+		if executed < s.batchSize {
+			return
+		}
+	}
+}
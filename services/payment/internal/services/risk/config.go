@@ -0,0 +1,142 @@
+package risk
+
+import (
+	"fmt"
+	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/shopspring/decimal"
+	"payment/internal/models"
+	"strings"
+	"time"
+)
+
+// Rule types
+const (
+	RuleAmount         = "amount"
+	RuleNewBeneficiary = "new_beneficiary"
+	RuleVelocity       = "velocity"
+	RuleCountry        = "country"
+	RuleTimeOfDay      = "time_of_day"
+)
+
+// RulesConfig - content of rules file, rules are evaluated all together, the most severe action wins
+type RulesConfig struct {
+	Rules []RuleConfig `yaml:"rules"`
+}
+
+// RuleConfig - fields used depend on Type:
+//   - amount: min_amount, optional currency
+//   - new_beneficiary: optional min_amount, recipient never received completed payment from owner
+//   - velocity: window, max_count - more than max_count payments of owner within window
+//   - country: countries - ISO 3166 codes of recipient account number
+//   - time_of_day: from, to ("HH:MM", range may wrap midnight), optional timezone and min_amount
+type RuleConfig struct {
+	Name      string        `yaml:"name"`
+	Type      string        `yaml:"type"`
+	Action    string        `yaml:"action"`
+	MinAmount string        `yaml:"min_amount"`
+	Currency  string        `yaml:"currency"`
+	Window    time.Duration `yaml:"window"`
+	MaxCount  int           `yaml:"max_count"`
+	Countries []string      `yaml:"countries"`
+	From      string        `yaml:"from"`
+	To        string        `yaml:"to"`
+	Timezone  string        `yaml:"timezone"`
+}
+
+// LoadRules - reads and validates rules file
+func LoadRules(path string) ([]Rule, error) {
+	var cfg RulesConfig
+	if err := cleanenv.ReadConfig(path, &cfg); err != nil {
+		return nil, fmt.Errorf("risk: read rules: %w", err)
+	}
+
+	return NewRules(cfg)
+}
+
+// NewRules - rules built from config
+func NewRules(cfg RulesConfig) ([]Rule, error) {
+	rules := make([]Rule, 0, len(cfg.Rules))
+	names := make(map[string]bool, len(cfg.Rules))
+
+	for _, rc := range cfg.Rules {
+		if rc.Name == "" || names[rc.Name] {
+			return nil, fmt.Errorf("risk: rule name %q is empty or duplicated", rc.Name)
+		}
+		names[rc.Name] = true
+
+		rule, err := newRule(rc)
+		if err != nil {
+			return nil, fmt.Errorf("risk: rule %q: %w", rc.Name, err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func newRule(rc RuleConfig) (Rule, error) {
+	action := models.RiskDecision(rc.Action)
+	if !action.IsValid() {
+		return nil, fmt.Errorf("invalid action %q", rc.Action)
+	}
+	base := baseRule{name: rc.Name, action: action}
+
+	minAmount := decimal.Zero
+	if rc.MinAmount != "" {
+		var err error
+		if minAmount, err = decimal.NewFromString(rc.MinAmount); err != nil || minAmount.IsNegative() {
+			return nil, fmt.Errorf("invalid min_amount %q", rc.MinAmount)
+		}
+	}
+
+	switch rc.Type {
+	case RuleAmount:
+		if rc.MinAmount == "" {
+			return nil, fmt.Errorf("min_amount is required")
+		}
+		return &amountRule{baseRule: base, minAmount: minAmount, currency: strings.ToUpper(rc.Currency)}, nil
+	case RuleNewBeneficiary:
+		return &newBeneficiaryRule{baseRule: base, minAmount: minAmount}, nil
+	case RuleVelocity:
+		if rc.Window <= 0 || rc.MaxCount <= 0 {
+			return nil, fmt.Errorf("positive window and max_count are required")
+		}
+		return &velocityRule{baseRule: base, window: rc.Window, maxCount: rc.MaxCount}, nil
+	case RuleCountry:
+		if len(rc.Countries) == 0 {
+			return nil, fmt.Errorf("countries are required")
+		}
+		countries := make(map[string]bool, len(rc.Countries))
+		for _, c := range rc.Countries {
+			countries[strings.ToUpper(c)] = true
+		}
+		return &countryRule{baseRule: base, countries: countries}, nil
+	case RuleTimeOfDay:
+		from, err := minuteOfDay(rc.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := minuteOfDay(rc.To)
+		if err != nil {
+			return nil, err
+		}
+		location := time.UTC
+		if rc.Timezone != "" {
+			if location, err = time.LoadLocation(rc.Timezone); err != nil {
+				return nil, fmt.Errorf("invalid timezone %q", rc.Timezone)
+			}
+		}
+		return &timeOfDayRule{baseRule: base, from: from, to: to, location: location, minAmount: minAmount}, nil
+	default:
+		return nil, fmt.Errorf("unknown type %q", rc.Type)
+	}
+}
+
+// minuteOfDay - minutes since midnight of "HH:MM"
+func minuteOfDay(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
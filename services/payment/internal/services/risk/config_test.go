@@ -0,0 +1,62 @@
+package risk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    RuleConfig
+		wantErr string
+	}{
+		{name: "amount", rule: RuleConfig{Type: RuleAmount, Action: "deny", MinAmount: "10000", Currency: "eur"}},
+		{name: "amount without min_amount", rule: RuleConfig{Type: RuleAmount, Action: "deny"}, wantErr: "min_amount is required"},
+		{name: "negative min_amount", rule: RuleConfig{Type: RuleAmount, Action: "deny", MinAmount: "-1"}, wantErr: "invalid min_amount"},
+		{name: "unknown action", rule: RuleConfig{Type: RuleAmount, Action: "block", MinAmount: "1"}, wantErr: "invalid action"},
+		{name: "new beneficiary", rule: RuleConfig{Type: RuleNewBeneficiary, Action: "review"}},
+		{name: "velocity", rule: RuleConfig{Type: RuleVelocity, Action: "review", Window: time.Hour, MaxCount: 10}},
+		{name: "velocity without window", rule: RuleConfig{Type: RuleVelocity, Action: "review", MaxCount: 10}, wantErr: "positive window and max_count"},
+		{name: "velocity with zero max_count", rule: RuleConfig{Type: RuleVelocity, Action: "review", Window: time.Hour}, wantErr: "positive window and max_count"},
+		{name: "country", rule: RuleConfig{Type: RuleCountry, Action: "deny", Countries: []string{"ir"}}},
+		{name: "country without countries", rule: RuleConfig{Type: RuleCountry, Action: "deny"}, wantErr: "countries are required"},
+		{name: "time of day", rule: RuleConfig{Type: RuleTimeOfDay, Action: "review", From: "23:00", To: "06:00", Timezone: "Europe/Berlin"}},
+		{name: "invalid time of day", rule: RuleConfig{Type: RuleTimeOfDay, Action: "review", From: "25:00", To: "06:00"}, wantErr: "invalid time of day"},
+		{name: "invalid timezone", rule: RuleConfig{Type: RuleTimeOfDay, Action: "review", From: "23:00", To: "06:00", Timezone: "Mars/Base"}, wantErr: "invalid timezone"},
+		{name: "unknown type", rule: RuleConfig{Type: "weather", Action: "deny"}, wantErr: "unknown type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.rule.Name = "rule"
+
+			rules, err := NewRules(RulesConfig{Rules: []RuleConfig{tt.rule}})
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, rules, 1)
+		})
+	}
+}
+
+func TestNewRulesNames(t *testing.T) {
+	rule := RuleConfig{Name: "large", Type: RuleAmount, Action: "deny", MinAmount: "1"}
+
+	_, err := NewRules(RulesConfig{Rules: []RuleConfig{rule, rule}})
+	require.ErrorContains(t, err, "empty or duplicated")
+
+	rule.Name = ""
+	_, err = NewRules(RulesConfig{Rules: []RuleConfig{rule}})
+	require.ErrorContains(t, err, "empty or duplicated")
+}
+
+func TestLoadRules(t *testing.T) {
+	rules, err := LoadRules("../../../config/fraud_rules.yaml")
+	require.NoError(t, err)
+	require.NotEmpty(t, rules)
+}
@@ -0,0 +1,49 @@
+package risk
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"payment/internal/models"
+	"time"
+)
+
+// History - previous payments of owner used by behavioural rules
+//
+//go:generate mockery --name=History
+type History interface {
+	HasPaidTo(ctx context.Context, ownerID uuid.UUID, to string) (bool, error)
+	CountPayments(ctx context.Context, ownerID uuid.UUID, since time.Time) (int, error)
+}
+
+// Engine - evaluates transfer against all rules
+type Engine struct {
+	rules   []Rule
+	history History
+}
+
+func NewEngine(rules []Rule, history History) *Engine {
+	return &Engine{rules: rules, history: history}
+}
+
+// Evaluate - decision of the most severe matched rule, allow when nothing matched
+func (e *Engine) Evaluate(ctx context.Context, in models.RiskInput) (models.RiskAssessment, error) {
+	result := models.RiskAssessment{Decision: models.RiskAllow}
+
+	for _, rule := range e.rules {
+		matched, err := rule.Match(ctx, in, e.history)
+		if err != nil {
+			return models.RiskAssessment{}, fmt.Errorf("risk: rule %q: %w", rule.Name(), err)
+		}
+		if !matched {
+			continue
+		}
+
+		result.Reasons = append(result.Reasons, rule.Name())
+		if rule.Action().Severity() > result.Decision.Severity() {
+			result.Decision = rule.Action()
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,87 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"payment/internal/models"
+)
+
+var errBoom = errors.New("boom")
+
+// history - owner paid to paidTo and made count payments, err fails every call
+type history struct {
+	paidTo string
+	count  int
+	err    error
+}
+
+func (h history) HasPaidTo(_ context.Context, _ uuid.UUID, to string) (bool, error) {
+	return to == h.paidTo, h.err
+}
+
+func (h history) CountPayments(_ context.Context, _ uuid.UUID, _ time.Time) (int, error) {
+	return h.count, h.err
+}
+
+func TestEngineEvaluate(t *testing.T) {
+	rules, err := NewRules(RulesConfig{Rules: []RuleConfig{
+		{Name: "large", Type: RuleAmount, Action: "review", MinAmount: "1000"},
+		{Name: "huge", Type: RuleAmount, Action: "deny", MinAmount: "10000"},
+		{Name: "new_beneficiary", Type: RuleNewBeneficiary, Action: "review"},
+		{Name: "velocity", Type: RuleVelocity, Action: "review", Window: time.Hour, MaxCount: 3},
+		{Name: "sanctioned", Type: RuleCountry, Action: "deny", Countries: []string{"IR"}},
+	}})
+	require.NoError(t, err)
+
+	const known = "DE89370400440532013000"
+
+	tests := []struct {
+		name         string
+		to           string
+		amount       string
+		count        int
+		wantDecision models.RiskDecision
+		wantReasons  []string
+	}{
+		{name: "nothing matched", to: known, amount: "10", wantDecision: models.RiskAllow},
+		{name: "single review", to: known, amount: "1000", wantDecision: models.RiskReview, wantReasons: []string{"large"}},
+		{name: "deny wins over review", to: known, amount: "10000", wantDecision: models.RiskDeny, wantReasons: []string{"large", "huge"}},
+		{name: "reviews are merged", to: "FR1420041010050500013M02606", amount: "10", count: 3,
+			wantDecision: models.RiskReview, wantReasons: []string{"new_beneficiary", "velocity"}},
+		{name: "deny of other rule wins", to: "IR062960000000100324200001", amount: "1000",
+			wantDecision: models.RiskDeny, wantReasons: []string{"large", "new_beneficiary", "sanctioned"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := NewEngine(rules, history{paidTo: known, count: tt.count})
+
+			assessment, err := engine.Evaluate(context.Background(), models.RiskInput{
+				OwnerID:  uuid.New(),
+				To:       tt.to,
+				Amount:   decimal.RequireFromString(tt.amount),
+				Currency: "EUR",
+				At:       time.Now(),
+			})
+			require.NoError(t, err)
+			require.Equal(t, tt.wantDecision, assessment.Decision)
+			require.Equal(t, tt.wantReasons, assessment.Reasons)
+		})
+	}
+}
+
+func TestEngineEvaluateHistoryError(t *testing.T) {
+	rules, err := NewRules(RulesConfig{Rules: []RuleConfig{
+		{Name: "velocity", Type: RuleVelocity, Action: "review", Window: time.Hour, MaxCount: 3},
+	}})
+	require.NoError(t, err)
+
+	_, err = NewEngine(rules, history{err: errBoom}).Evaluate(context.Background(), models.RiskInput{At: time.Now()})
+	require.ErrorIs(t, err, errBoom)
+}
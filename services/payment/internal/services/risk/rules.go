@@ -0,0 +1,107 @@
+package risk
+
+import (
+	"context"
+	"github.com/shopspring/decimal"
+	"payment/internal/models"
+	"time"
+)
+
+// Rule - single risk check, Match reports whether transfer triggers the rule
+type Rule interface {
+	Name() string
+	Action() models.RiskDecision
+	Match(ctx context.Context, in models.RiskInput, history History) (bool, error)
+}
+
+type baseRule struct {
+	name   string
+	action models.RiskDecision
+}
+
+func (r baseRule) Name() string {
+	return r.name
+}
+
+func (r baseRule) Action() models.RiskDecision {
+	return r.action
+}
+
+// amountRule - amount at or above threshold, in given currency only if it's set
+type amountRule struct {
+	baseRule
+	minAmount decimal.Decimal
+	currency  string
+}
+
+func (r *amountRule) Match(_ context.Context, in models.RiskInput, _ History) (bool, error) {
+	if r.currency != "" && r.currency != in.Currency {
+		return false, nil
+	}
+	return in.Amount.GreaterThanOrEqual(r.minAmount), nil
+}
+
+// newBeneficiaryRule - owner has never completed payment to recipient
+type newBeneficiaryRule struct {
+	baseRule
+	minAmount decimal.Decimal
+}
+
+func (r *newBeneficiaryRule) Match(ctx context.Context, in models.RiskInput, history History) (bool, error) {
+	if in.Amount.LessThan(r.minAmount) {
+		return false, nil
+	}
+
+	known, err := history.HasPaidTo(ctx, in.OwnerID, in.To)
+	if err != nil {
+		return false, err
+	}
+	return !known, nil
+}
+
+// velocityRule - owner already made maxCount payments within window
+type velocityRule struct {
+	baseRule
+	window   time.Duration
+	maxCount int
+}
+
+func (r *velocityRule) Match(ctx context.Context, in models.RiskInput, history History) (bool, error) {
+	count, err := history.CountPayments(ctx, in.OwnerID, in.At.Add(-r.window))
+	if err != nil {
+		return false, err
+	}
+	return count >= r.maxCount, nil
+}
+
+// countryRule - recipient account number is issued in one of countries
+type countryRule struct {
+	baseRule
+	countries map[string]bool
+}
+
+func (r *countryRule) Match(_ context.Context, in models.RiskInput, _ History) (bool, error) {
+	return len(in.To) >= 2 && r.countries[in.To[:2]], nil
+}
+
+// timeOfDayRule - request made within [from, to) of local time, range wraps midnight when from > to
+type timeOfDayRule struct {
+	baseRule
+	from, to  int
+	location  *time.Location
+	minAmount decimal.Decimal
+}
+
+func (r *timeOfDayRule) Match(_ context.Context, in models.RiskInput, _ History) (bool, error) {
+	if in.Amount.LessThan(r.minAmount) {
+		return false, nil
+	}
+
+	local := in.At.In(r.location)
+	minute := local.Hour()*60 + local.Minute()
+
+	if r.from <= r.to {
+		return minute >= r.from && minute < r.to, nil
+	}
+	return minute >= r.from || minute < r.to, nil
+}
@@ -0,0 +1,63 @@
+package risk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"payment/internal/models"
+)
+
+func TestTimeOfDayRule(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		from, to string
+		location *time.Location
+		at       time.Time
+		amount   string
+		want     bool
+	}{
+		{name: "inside range", from: "09:00", to: "17:00", at: utc(12, 0), want: true},
+		{name: "to is exclusive", from: "09:00", to: "17:00", at: utc(17, 0)},
+		{name: "from is inclusive", from: "09:00", to: "17:00", at: utc(9, 0), want: true},
+		{name: "before range", from: "09:00", to: "17:00", at: utc(8, 59)},
+		{name: "wraps midnight, before midnight", from: "23:00", to: "06:00", at: utc(23, 30), want: true},
+		{name: "wraps midnight, after midnight", from: "23:00", to: "06:00", at: utc(5, 59), want: true},
+		{name: "wraps midnight, outside", from: "23:00", to: "06:00", at: utc(12, 0)},
+		{name: "wraps midnight, to is exclusive", from: "23:00", to: "06:00", at: utc(6, 0)},
+		{name: "local time of timezone", from: "23:00", to: "06:00", location: berlin, at: utc(22, 30), want: true},
+		{name: "below min_amount", from: "23:00", to: "06:00", at: utc(23, 30), amount: "99.99"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			location := tt.location
+			if location == nil {
+				location = time.UTC
+			}
+			amount := tt.amount
+			if amount == "" {
+				amount = "100"
+			}
+
+			from, err := minuteOfDay(tt.from)
+			require.NoError(t, err)
+			to, err := minuteOfDay(tt.to)
+			require.NoError(t, err)
+			rule := &timeOfDayRule{from: from, to: to, location: location, minAmount: decimal.NewFromInt(100)}
+
+			matched, err := rule.Match(context.Background(), models.RiskInput{Amount: decimal.RequireFromString(amount), At: tt.at}, nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, matched)
+		})
+	}
+}
+
+func utc(hour, minute int) time.Time {
+	return time.Date(2026, time.January, 15, hour, minute, 0, 0, time.UTC)
+}
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_scheduled_payment_executions_schedule_id;
+DROP TABLE IF EXISTS scheduled_payment_executions;
+DROP INDEX IF EXISTS idx_scheduled_payments_due;
+DROP INDEX IF EXISTS idx_scheduled_payments_owner_id;
+DROP TABLE IF EXISTS scheduled_payments;
@@ -0,0 +1,41 @@
+-- standing orders, next_run_at is the moment of next attempt, scheduled_for - occurrence it belongs to
+CREATE TABLE IF NOT EXISTS scheduled_payments (
+    id uuid PRIMARY KEY,
+    owner_id uuid NOT NULL,
+    from_account VARCHAR(34) NOT NULL,
+    to_account VARCHAR(34) NOT NULL,
+    amount NUMERIC(19,4) NOT NULL CHECK (amount > 0),
+    currency VARCHAR(3) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    recurrence_type VARCHAR(16) NOT NULL CHECK (recurrence_type IN ('once', 'weekly', 'monthly', 'cron')),
+    recurrence_interval INT NOT NULL DEFAULT 1 CHECK (recurrence_interval > 0),
+    day_of_month INT CHECK (day_of_month BETWEEN 1 AND 31),
+    cron_expr VARCHAR(255) NOT NULL DEFAULT '',
+    start_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    end_at TIMESTAMP WITH TIME ZONE,
+    scheduled_for TIMESTAMP WITH TIME ZONE,
+    next_run_at TIMESTAMP WITH TIME ZONE,
+    attempt INT NOT NULL DEFAULT 0,
+    max_retries INT NOT NULL DEFAULT 0 CHECK (max_retries >= 0),
+    status VARCHAR(16) NOT NULL CHECK (status IN ('active', 'cancelled', 'completed')),
+    last_run_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_payments_owner_id ON scheduled_payments(owner_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_scheduled_payments_due ON scheduled_payments(next_run_at) WHERE status = 'active';
+
+CREATE TABLE IF NOT EXISTS scheduled_payment_executions (
+    id uuid PRIMARY KEY,
+    schedule_id uuid NOT NULL REFERENCES scheduled_payments(id),
+    scheduled_for TIMESTAMP WITH TIME ZONE NOT NULL,
+    attempt INT NOT NULL,
+    status VARCHAR(32) NOT NULL CHECK (status IN ('succeeded', 'insufficient_funds', 'failed')),
+    transaction_id uuid,
+    error TEXT NOT NULL DEFAULT '',
+    executed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (schedule_id, scheduled_for, attempt)
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_payment_executions_schedule_id ON scheduled_payment_executions(schedule_id, executed_at);
@@ -0,0 +1,9 @@
+ALTER TABLE scheduled_payment_executions DROP CONSTRAINT IF EXISTS scheduled_payment_executions_status_check;
+ALTER TABLE scheduled_payment_executions ADD CONSTRAINT scheduled_payment_executions_status_check
+    CHECK (status IN ('succeeded', 'insufficient_funds', 'failed'));
+ALTER TABLE scheduled_payment_executions DROP COLUMN IF EXISTS payment_id;
+
+DROP INDEX IF EXISTS idx_payments_pending_review;
+DROP INDEX IF EXISTS idx_payments_owner_to_account;
+DROP INDEX IF EXISTS idx_payments_owner_id;
+DROP TABLE IF EXISTS payments;
@@ -0,0 +1,32 @@
+-- one-off payments, risk_decision is outcome of pre-authorization checks, review queue is status 'pending_review'
+CREATE TABLE IF NOT EXISTS payments (
+    id uuid PRIMARY KEY,
+    owner_id uuid NOT NULL,
+    from_account VARCHAR(34) NOT NULL,
+    to_account VARCHAR(34) NOT NULL,
+    amount NUMERIC(19,4) NOT NULL CHECK (amount > 0),
+    currency VARCHAR(3) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    status VARCHAR(16) NOT NULL CHECK (status IN ('processing', 'pending_review', 'completed', 'failed', 'denied', 'rejected')),
+    risk_decision VARCHAR(8) NOT NULL CHECK (risk_decision IN ('allow', 'review', 'deny')),
+    risk_reasons TEXT[] NOT NULL DEFAULT '{}',
+    transaction_id uuid,
+    error TEXT NOT NULL DEFAULT '',
+    idempotency_key VARCHAR(255),
+    reviewed_by VARCHAR(255),
+    review_comment TEXT NOT NULL DEFAULT '',
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (owner_id, idempotency_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payments_owner_id ON payments(owner_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_payments_owner_to_account ON payments(owner_id, to_account) WHERE status = 'completed';
+CREATE INDEX IF NOT EXISTS idx_payments_pending_review ON payments(created_at) WHERE status = 'pending_review';
+
+-- occurrence of scheduled payment that needs review waits in the same queue as payment
+ALTER TABLE scheduled_payment_executions ADD COLUMN IF NOT EXISTS payment_id uuid REFERENCES payments(id);
+ALTER TABLE scheduled_payment_executions DROP CONSTRAINT IF EXISTS scheduled_payment_executions_status_check;
+ALTER TABLE scheduled_payment_executions ADD CONSTRAINT scheduled_payment_executions_status_check
+    CHECK (status IN ('succeeded', 'insufficient_funds', 'failed', 'denied', 'pending_review'));
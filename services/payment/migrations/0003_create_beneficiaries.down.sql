@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_beneficiaries_owner_id;
+DROP TABLE IF EXISTS beneficiaries;
@@ -0,0 +1,18 @@
+-- saved payees of user, verification is result of matching name against name of account in account service
+CREATE TABLE IF NOT EXISTS beneficiaries (
+    id uuid PRIMARY KEY,
+    owner_id uuid NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    nickname VARCHAR(255) NOT NULL DEFAULT '',
+    account_number VARCHAR(34) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    verification_status VARCHAR(16) NOT NULL CHECK (verification_status IN ('match', 'close_match', 'no_match')),
+    verified_name VARCHAR(255) NOT NULL DEFAULT '',
+    verified_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    cooling_off_until TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (owner_id, account_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_beneficiaries_owner_id ON beneficiaries(owner_id, created_at);